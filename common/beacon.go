@@ -85,11 +85,17 @@ func (b *Beacon) Unmarshal(buff []byte) error {
 	return json.Unmarshal(buff, b)
 }
 
-// Randomness returns the hashed signature. The choice of the hash determines the size of the output.
+// Randomness returns the signature hashed with the default sha256 digest. Use RandomnessFor to derive it with the
+// digest of the scheme of the chain.
 func (b *Beacon) Randomness() []byte {
 	return crypto.RandomnessFromSignature(b.Signature)
 }
 
+// RandomnessFor returns the signature hashed with the digest of the given scheme
+func (b *Beacon) RandomnessFor(sch *crypto.Scheme) []byte {
+	return sch.RandomnessFromSignature(b.Signature)
+}
+
 func (b *Beacon) GetRandomness() []byte {
 	return b.Randomness()
 }
@@ -0,0 +1,49 @@
+package chain
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/drand/drand/v2/common"
+)
+
+// AssertConsistent checks that beacons gathered from several nodes of a chain agree with each other: beacons for
+// the same round must be identical, and a chained beacon must link to the signature of the previous round when
+// that round was supplied too. Beacons are identified by their position in the arguments in the returned error, so
+// callers passing one beacon per node can tell which node diverged. It doesn't verify the signatures, use Verify or
+// VerifyBatch for that.
+func AssertConsistent(beacons ...*common.Beacon) error {
+	// the index of the first beacon seen for each round, the others are compared to it
+	first := make(map[uint64]int)
+	for i, b := range beacons {
+		if b == nil {
+			return fmt.Errorf("beacon %d is nil", i)
+		}
+		j, ok := first[b.Round]
+		if !ok {
+			first[b.Round] = i
+			continue
+		}
+		ref := beacons[j]
+		switch {
+		case !bytes.Equal(b.Signature, ref.Signature):
+			return fmt.Errorf("beacon %d diverges from beacon %d for round %d: different signature", i, j, b.Round)
+		case !bytes.Equal(b.PreviousSig, ref.PreviousSig):
+			return fmt.Errorf("beacon %d diverges from beacon %d for round %d: different previous signature", i, j, b.Round)
+		}
+	}
+
+	for i, b := range beacons {
+		if len(b.PreviousSig) == 0 || b.Round == 0 {
+			continue
+		}
+		j, ok := first[b.Round-1]
+		if !ok {
+			continue
+		}
+		if !bytes.Equal(b.PreviousSig, beacons[j].Signature) {
+			return fmt.Errorf("beacon %d for round %d doesn't link to beacon %d for round %d", i, b.Round, j, b.Round-1)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/v2/common"
+	"github.com/drand/drand/v2/crypto"
+)
+
+func TestAssertConsistent(t *testing.T) {
+	sch, err := crypto.SchemeFromName(crypto.DefaultSchemeID)
+	require.NoError(t, err)
+	_, beacons := signedChain(t, sch, 3)
+
+	copyOf := func(b *common.Beacon) *common.Beacon {
+		c := *b
+		return &c
+	}
+
+	require.NoError(t, AssertConsistent())
+	require.NoError(t, AssertConsistent(beacons...))
+	require.NoError(t, AssertConsistent(beacons[1], copyOf(beacons[1]), copyOf(beacons[1])))
+	// rounds that aren't consecutive can't be linked, and aren't compared
+	require.NoError(t, AssertConsistent(beacons[0], beacons[2]))
+
+	diverging := copyOf(beacons[1])
+	diverging.Signature = beacons[2].Signature
+	err = AssertConsistent(beacons[1], copyOf(beacons[1]), diverging)
+	require.ErrorContains(t, err, "beacon 2 diverges from beacon 0 for round 2: different signature")
+
+	diverging = copyOf(beacons[1])
+	diverging.PreviousSig = beacons[1].Signature
+	err = AssertConsistent(beacons[1], diverging)
+	require.ErrorContains(t, err, "beacon 1 diverges from beacon 0 for round 2: different previous signature")
+
+	unlinked := copyOf(beacons[2])
+	unlinked.PreviousSig = beacons[0].Signature
+	err = AssertConsistent(beacons[0], beacons[1], unlinked)
+	require.ErrorContains(t, err, "beacon 2 for round 3 doesn't link to beacon 1 for round 2")
+
+	require.ErrorContains(t, AssertConsistent(beacons[0], nil), "beacon 1 is nil")
+}
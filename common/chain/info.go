@@ -5,11 +5,13 @@ import (
 	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
+	"strconv"
 	"time"
 
 	"github.com/drand/drand/v2/common"
 	"github.com/drand/drand/v2/common/key"
 	"github.com/drand/drand/v2/common/log"
+	"github.com/drand/drand/v2/crypto"
 	"github.com/drand/kyber"
 )
 
@@ -36,6 +38,21 @@ func NewChainInfo(g *key.Group) *Info {
 	}
 }
 
+// InfoFromPublicKey makes the chain Info from the public parameters of a chain, for observers that don't have
+// its group file. The genesis seed is the "group_hash" of the chain info, which the hash of the chain covers along
+// with the beacon ID, so that the returned Info has the canonical hash of the chain.
+func InfoFromPublicKey(pk kyber.Point, period time.Duration, genesis int64, genesisSeed []byte, beaconID string,
+	scheme *crypto.Scheme) *Info {
+	return &Info{
+		ID:          beaconID,
+		PublicKey:   pk,
+		Period:      period,
+		Scheme:      scheme.Name,
+		GenesisTime: genesis,
+		GenesisSeed: genesisSeed,
+	}
+}
+
 // Hash returns the canonical hash representing the chain information. A hash is
 // consistent throughout the entirety of a chain, regardless of the network
 // composition, the actual nodes, generating the randomness.
@@ -79,3 +96,42 @@ func (c *Info) Equal(c2 *Info) bool {
 func (c *Info) GetSchemeName() string {
 	return c.Scheme
 }
+
+// InfoDifference is a field on which two chain infos differ, with the value it has in each of them.
+// Binary values are hex encoded.
+type InfoDifference struct {
+	Field string `json:"field"`
+	A     string `json:"a"`
+	B     string `json:"b"`
+}
+
+// Compatible compares two chain infos and returns the fields on which they differ. Both describe the same
+// chain, and beacons of one can be verified with the other, only if the returned slice is empty.
+func (c *Info) Compatible(c2 *Info) []InfoDifference {
+	var diffs []InfoDifference
+	add := func(field, a, b string) {
+		if a != b {
+			diffs = append(diffs, InfoDifference{Field: field, A: a, B: b})
+		}
+	}
+
+	if !common.CompareBeaconIDs(c.ID, c2.ID) {
+		diffs = append(diffs, InfoDifference{Field: "id", A: c.ID, B: c2.ID})
+	}
+	add("period", c.Period.String(), c2.Period.String())
+	add("genesis_time", strconv.FormatInt(c.GenesisTime, 10), strconv.FormatInt(c2.GenesisTime, 10))
+	add("scheme", c.Scheme, c2.Scheme)
+	add("public_key", pointToHex(c.PublicKey), pointToHex(c2.PublicKey))
+	add("group_hash", hex.EncodeToString(c.GenesisSeed), hex.EncodeToString(c2.GenesisSeed))
+	add("hash", c.HashString(), c2.HashString())
+
+	return diffs
+}
+
+func pointToHex(p kyber.Point) string {
+	if p == nil {
+		return ""
+	}
+	buff, _ := p.MarshalBinary()
+	return hex.EncodeToString(buff)
+}
@@ -3,8 +3,11 @@ package chain
 import (
 	"bytes"
 	"encoding/hex"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
+	"unicode/utf8"
 
 	"github.com/stretchr/testify/require"
 
@@ -106,3 +109,85 @@ func TestChainInfo(t *testing.T) {
 	})
 	require.Equal(t, beaconID, packet.Metadata.BeaconID)
 }
+
+func TestInfoFromPublicKey(t *testing.T) {
+	sch, err := crypto.GetSchemeFromEnv()
+	require.NoError(t, err)
+
+	for _, beaconID := range []string{"", "test_beacon"} {
+		_, g := test.BatchIdentities(t, 3, sch, beaconID)
+		canonical := NewChainInfo(g)
+
+		c := InfoFromPublicKey(g.PublicKey.Key(), g.Period, g.GenesisTime, g.GetGenesisSeed(), beaconID, sch)
+		require.Equal(t, sch.Name, c.GetSchemeName())
+		require.Equal(t, canonical.Period, c.Period)
+		require.True(t, canonical.PublicKey.Equal(c.PublicKey))
+		require.Equal(t, NewChainInfo(g).Hash(), c.Hash())
+		require.True(t, canonical.Equal(c))
+	}
+}
+
+func TestChainInfoCompatible(t *testing.T) {
+	sch, err := crypto.GetSchemeFromEnv()
+	require.NoError(t, err)
+	beaconID := "test_beacon"
+
+	_, g1 := test.BatchIdentities(t, 3, sch, beaconID)
+	c1 := NewChainInfo(g1)
+	c2 := NewChainInfo(g1)
+	require.Empty(t, c1.Compatible(c2))
+
+	c2.GenesisTime++
+	diffs := c1.Compatible(c2)
+	require.Len(t, diffs, 2)
+	require.Equal(t, "genesis_time", diffs[0].Field)
+	require.Equal(t, strconv.FormatInt(c1.GenesisTime, 10), diffs[0].A)
+	require.Equal(t, strconv.FormatInt(c2.GenesisTime, 10), diffs[0].B)
+	require.Equal(t, "hash", diffs[1].Field)
+
+	_, g2 := test.BatchIdentities(t, 3, sch, beaconID)
+	fields := make([]string, 0)
+	for _, d := range c1.Compatible(NewChainInfo(g2)) {
+		fields = append(fields, d.Field)
+	}
+	require.Contains(t, fields, "public_key")
+	require.Contains(t, fields, "hash")
+}
+
+func FuzzInfoJSONRoundTrip(f *testing.F) {
+	schemes := crypto.ListSchemes()
+	f.Add(uint8(0), "", uint32(30), int64(1595431050), []byte("seed"), []byte{1})
+	f.Add(uint8(1), "default", uint32(3), int64(0), []byte(nil), []byte{2})
+	f.Add(uint8(2), "test_beacon", uint32(1), int64(-1), []byte{}, []byte{3})
+	f.Add(uint8(3), "quicknet", uint32(3), int64(1692803367), []byte{0xff, 0}, []byte{4})
+
+	f.Fuzz(func(t *testing.T, schemeIdx uint8, id string, period uint32, genesis int64, seed, secret []byte) {
+		sch, err := crypto.SchemeFromName(schemes[int(schemeIdx)%len(schemes)])
+		require.NoError(t, err)
+		if !utf8.ValidString(id) {
+			t.Skip("beacon ids are valid strings")
+		}
+		scalar := sch.KeyGroup.Scalar().SetBytes(secret)
+		c := &Info{
+			PublicKey:   sch.KeyGroup.Point().Mul(scalar, nil),
+			ID:          id,
+			Period:      time.Duration(period) * time.Second,
+			Scheme:      sch.Name,
+			GenesisTime: genesis,
+			GenesisSeed: seed,
+		}
+
+		var buff bytes.Buffer
+		require.NoError(t, c.ToJSON(&buff, nil))
+		encoded := buff.String()
+		c2, err := InfoFromJSON(&buff)
+		require.NoError(t, err)
+		require.True(t, c.Equal(c2))
+		require.Equal(t, c.ID, c2.ID)
+		require.Equal(t, c.Hash(), c2.Hash())
+
+		// the encoding is stable
+		require.NoError(t, c2.ToJSON(&buff, nil))
+		require.Equal(t, encoded, buff.String())
+	})
+}
@@ -0,0 +1,127 @@
+package chain
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/drand/drand/v2/common"
+	"github.com/drand/drand/v2/crypto"
+	"github.com/drand/kyber"
+	"github.com/drand/kyber/util/random"
+)
+
+// VerifyingStream verifies the beacons of a chain one at a time, in increasing round order, keeping only the last
+// verified beacon in memory. It lets tools verify long ranges of rounds as they are read from a store or fetched
+// over the network.
+type VerifyingStream struct {
+	scheme *crypto.Scheme
+	public kyber.Point
+	last   *common.Beacon
+}
+
+// NewVerifyingStream returns a VerifyingStream for the beacons of the chain described by info
+func NewVerifyingStream(info *Info) (*VerifyingStream, error) {
+	sch, err := crypto.SchemeFromName(info.Scheme)
+	if err != nil {
+		return nil, err
+	}
+	if info.PublicKey == nil {
+		return nil, errors.New("chain info has no public key")
+	}
+	return &VerifyingStream{scheme: sch, public: info.PublicKey}, nil
+}
+
+// Push verifies the given beacon and, for chained schemes, that it links to the previously pushed one. Beacons must
+// be pushed in increasing round order and, for chained schemes, without gaps. A beacon failing verification isn't
+// retained, so the stream can't be resumed past it.
+func (v *VerifyingStream) Push(b *common.Beacon) error {
+	if v.last != nil && b.Round <= v.last.Round {
+		return fmt.Errorf("round %d pushed after round %d, rounds must be increasing", b.Round, v.last.Round)
+	}
+
+	if v.scheme.Name == crypto.DefaultSchemeID && v.last != nil {
+		if b.Round != v.last.Round+1 {
+			return fmt.Errorf("round %d pushed after round %d, chained rounds can't be skipped", b.Round, v.last.Round)
+		}
+		if !bytes.Equal(b.PreviousSig, v.last.Signature) {
+			return fmt.Errorf("round %d doesn't link to the signature of round %d", b.Round, v.last.Round)
+		}
+	}
+
+	if err := v.scheme.VerifyBeacon(b, v.public); err != nil {
+		return fmt.Errorf("invalid round %d: %w", b.Round, err)
+	}
+
+	last := *b
+	v.last = &last
+	return nil
+}
+
+// Last returns the last beacon successfully verified, or nil if none was
+func (v *VerifyingStream) Last() *common.Beacon {
+	return v.last
+}
+
+// ErrBatchVerificationUnsupported is returned by VerifyBatch for the schemes that don't support it
+var ErrBatchVerificationUnsupported = errors.New("the scheme of the chain doesn't support batch verification")
+
+type hashablePoint interface {
+	Hash([]byte) kyber.Point
+}
+
+// VerifyBatch verifies the given beacons of an unchained scheme with two pairings in total instead of one per
+// beacon, by checking a random linear combination of their signatures. If that check fails, the beacons are
+// verified one by one so that the returned error names the first invalid round.
+func VerifyBatch(info *Info, beacons []*common.Beacon) error {
+	sch, err := crypto.SchemeFromName(info.Scheme)
+	if err != nil {
+		return err
+	}
+	if !sch.SupportsBatchVerification() {
+		return fmt.Errorf("%w: %s", ErrBatchVerificationUnsupported, sch.Name)
+	}
+	if info.PublicKey == nil {
+		return errors.New("chain info has no public key")
+	}
+	if len(beacons) == 0 {
+		return nil
+	}
+
+	if batchVerify(sch, info.PublicKey, beacons) {
+		return nil
+	}
+	for _, b := range beacons {
+		if err := sch.VerifyBeacon(b, info.PublicKey); err != nil {
+			return fmt.Errorf("invalid round %d: %w", b.Round, err)
+		}
+	}
+	return errors.New("batch verification failed but every round is valid on its own")
+}
+
+// batchVerify checks that e(sum(r_i * sig_i), base) == e(sum(r_i * H(m_i)), public), for random r_i which prevent
+// invalid signatures from cancelling each other out
+func batchVerify(sch *crypto.Scheme, public kyber.Point, beacons []*common.Beacon) bool {
+	sigs := sch.SigGroup.Point().Null()
+	msgs := sch.SigGroup.Point().Null()
+	for _, b := range beacons {
+		sig := sch.SigGroup.Point()
+		if err := sig.UnmarshalBinary(b.Signature); err != nil {
+			return false
+		}
+		hashable, ok := sch.SigGroup.Point().(hashablePoint)
+		if !ok {
+			return false
+		}
+		r := sch.KeyGroup.Scalar().Pick(random.New())
+		sigs.Add(sigs, sig.Mul(r, sig))
+		msg := hashable.Hash(sch.DigestBeacon(b))
+		msgs.Add(msgs, msg.Mul(r, msg))
+	}
+
+	base := sch.KeyGroup.Point().Base()
+	if sch.SigGroup.String() == sch.Pairing.G1().String() {
+		return sch.Pairing.ValidatePairing(msgs, public, sigs, base)
+	}
+	return sch.Pairing.ValidatePairing(public, msgs, base, sigs)
+}
@@ -0,0 +1,112 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/v2/common"
+	"github.com/drand/drand/v2/crypto"
+	"github.com/drand/kyber/util/random"
+)
+
+// signedChain returns n consecutive rounds starting at round 1, signed with a fresh key for the given scheme
+func signedChain(t *testing.T, sch *crypto.Scheme, n int) (*Info, []*common.Beacon) {
+	t.Helper()
+	secret := sch.KeyGroup.Scalar().Pick(random.New())
+	info := &Info{PublicKey: sch.KeyGroup.Point().Mul(secret, nil), Scheme: sch.Name}
+
+	beacons := make([]*common.Beacon, n)
+	prev := []byte("genesis seed")
+	for i := range beacons {
+		b := &common.Beacon{Round: uint64(i + 1)}
+		if sch.Name == crypto.DefaultSchemeID {
+			b.PreviousSig = prev
+		}
+		sig, err := sch.AuthScheme.Sign(secret, sch.DigestBeacon(b))
+		require.NoError(t, err)
+		b.Signature = sig
+		prev = sig
+		beacons[i] = b
+	}
+	return info, beacons
+}
+
+func TestVerifyingStream(t *testing.T) {
+	for _, name := range crypto.ListSchemes() {
+		t.Run(name, func(t *testing.T) {
+			sch, err := crypto.SchemeFromName(name)
+			require.NoError(t, err)
+			info, beacons := signedChain(t, sch, 5)
+
+			stream, err := NewVerifyingStream(info)
+			require.NoError(t, err)
+			require.Nil(t, stream.Last())
+			for _, b := range beacons {
+				require.NoError(t, stream.Push(b))
+			}
+			require.Equal(t, beacons[4].Round, stream.Last().Round)
+
+			// rounds can't go backward
+			require.Error(t, stream.Push(beacons[2]))
+
+			// a tampered signature is detected
+			stream, err = NewVerifyingStream(info)
+			require.NoError(t, err)
+			require.NoError(t, stream.Push(beacons[0]))
+			tampered := *beacons[1]
+			tampered.Signature = beacons[2].Signature
+			require.Error(t, stream.Push(&tampered))
+			require.Equal(t, beacons[0].Round, stream.Last().Round)
+		})
+	}
+}
+
+func TestVerifyingStreamChainedLinkage(t *testing.T) {
+	sch, err := crypto.SchemeFromName(crypto.DefaultSchemeID)
+	require.NoError(t, err)
+	info, beacons := signedChain(t, sch, 3)
+
+	stream, err := NewVerifyingStream(info)
+	require.NoError(t, err)
+	require.NoError(t, stream.Push(beacons[0]))
+	// chained rounds can't be skipped since the link with the previous round can't be checked
+	require.Error(t, stream.Push(beacons[2]))
+
+	// a round of another chain doesn't link to the previous one, even though it is correctly signed for its own chain
+	other, otherBeacons := signedChain(t, sch, 2)
+	require.NoError(t, stream.Push(beacons[1]))
+	otherStream, err := NewVerifyingStream(other)
+	require.NoError(t, err)
+	require.NoError(t, otherStream.Push(otherBeacons[0]))
+	require.Error(t, otherStream.Push(beacons[1]))
+}
+
+func TestVerifyBatch(t *testing.T) {
+	for _, name := range crypto.ListSchemes() {
+		t.Run(name, func(t *testing.T) {
+			sch, err := crypto.SchemeFromName(name)
+			require.NoError(t, err)
+			info, beacons := signedChain(t, sch, 6)
+
+			if !sch.SupportsBatchVerification() {
+				require.ErrorIs(t, VerifyBatch(info, beacons), ErrBatchVerificationUnsupported)
+				return
+			}
+
+			require.NoError(t, VerifyBatch(info, nil))
+			require.NoError(t, VerifyBatch(info, beacons))
+			// rounds don't need to be consecutive
+			require.NoError(t, VerifyBatch(info, []*common.Beacon{beacons[4], beacons[1]}))
+
+			// swapping two signatures keeps their sum unchanged, the random combination still catches it
+			swapped := []*common.Beacon{beacons[0], {Round: 2, Signature: beacons[2].Signature}, {Round: 3, Signature: beacons[1].Signature}}
+			err = VerifyBatch(info, swapped)
+			require.ErrorContains(t, err, "invalid round 2")
+
+			// the signatures of another chain are rejected
+			other, _ := signedChain(t, sch, 1)
+			require.Error(t, VerifyBatch(other, beacons))
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/drand/drand/v2/common"
+	"github.com/drand/drand/v2/common/chain"
+	"github.com/drand/drand/v2/crypto"
+)
+
+// BatchProof bundles a set of rounds with the material needed to verify them independently, so that an
+// application can prove to a third party which randomness it used.
+type BatchProof struct {
+	// Scheme is the name of the scheme of the chain the rounds belong to
+	Scheme string `json:"scheme"`
+	// PublicKey is the public key of the chain the rounds belong to
+	PublicKey common.HexBytes `json:"public_key"`
+	// ChainHash is the hash of the chain the rounds belong to
+	ChainHash common.HexBytes `json:"chain_hash"`
+	// Beacons are the rounds of the batch, in the order they were requested
+	Beacons []common.Beacon `json:"beacons"`
+}
+
+// previousSignatureResult is implemented by the results carrying the previous signature of their round,
+// which the chained schemes need to verify them
+type previousSignatureResult interface {
+	GetPreviousSignature() []byte
+}
+
+// NewBatchProof fetches the given rounds from c and bundles them with the public key and the scheme of the
+// chain c is connected to.
+func NewBatchProof(ctx context.Context, c Client, rounds ...uint64) (*BatchProof, error) {
+	if len(rounds) == 0 {
+		return nil, errors.New("no round to prove")
+	}
+
+	info, err := c.Info(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get chain info: %w", err)
+	}
+	sch, err := crypto.SchemeFromName(info.Scheme)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := info.PublicKey.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	proof := &BatchProof{
+		Scheme:    sch.Name,
+		PublicKey: pub,
+		ChainHash: info.Hash(),
+		Beacons:   make([]common.Beacon, 0, len(rounds)),
+	}
+	for _, round := range rounds {
+		if round == 0 {
+			return nil, errors.New("rounds of a batch proof must be explicit, round 0 is the latest one")
+		}
+		res, err := c.Get(ctx, round)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get round %d: %w", round, err)
+		}
+		if res.GetRound() != round {
+			return nil, fmt.Errorf("asked for round %d but got round %d", round, res.GetRound())
+		}
+
+		b := common.Beacon{Round: round, Signature: res.GetSignature()}
+		if sch.Name == crypto.DefaultSchemeID {
+			b.PreviousSig, err = previousSignature(ctx, c, res)
+			if err != nil {
+				return nil, err
+			}
+		}
+		proof.Beacons = append(proof.Beacons, b)
+	}
+
+	return proof, nil
+}
+
+// previousSignature returns the previous signature of a chained round, fetching the previous round if the
+// result doesn't carry it
+func previousSignature(ctx context.Context, c Client, res Result) ([]byte, error) {
+	if r, ok := res.(previousSignatureResult); ok && len(r.GetPreviousSignature()) > 0 {
+		return r.GetPreviousSignature(), nil
+	}
+	if res.GetRound() <= 1 {
+		return nil, fmt.Errorf("unable to get the previous signature of round %d", res.GetRound())
+	}
+	prev, err := c.Get(ctx, res.GetRound()-1)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get round %d: %w", res.GetRound()-1, err)
+	}
+	return prev.GetSignature(), nil
+}
+
+// Verify checks the proof is for the given chain and the signature of every round of the batch against the public
+// key of that chain. It doesn't rely on any drand node, only on the content of the proof and on the chain info the
+// verifier trusts: the public key and chain hash carried by the proof are never trusted on their own.
+func (p *BatchProof) Verify(info *chain.Info) error {
+	if info == nil {
+		return errors.New("no chain info to verify the batch proof against")
+	}
+	if !bytes.Equal(p.ChainHash, info.Hash()) {
+		return fmt.Errorf("batch proof is for chain %x, not %s", []byte(p.ChainHash), info.HashString())
+	}
+	sch, err := crypto.SchemeFromName(info.Scheme)
+	if err != nil {
+		return err
+	}
+	if p.Scheme != sch.Name {
+		return fmt.Errorf("batch proof is for scheme %s, the chain uses %s", p.Scheme, sch.Name)
+	}
+	pub, err := info.PublicKey.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(p.PublicKey, pub) {
+		return errors.New("the public key of the batch proof isn't the one of the chain")
+	}
+	if len(p.Beacons) == 0 {
+		return errors.New("batch proof has no round")
+	}
+
+	for i := range p.Beacons {
+		if err := sch.VerifyBeacon(&p.Beacons[i], info.PublicKey); err != nil {
+			return fmt.Errorf("invalid round %d: %w", p.Beacons[i].Round, err)
+		}
+	}
+	return nil
+}
+
+// Randomness returns the randomness of the rounds of the batch, in the same order as its beacons. It is derived
+// with the digest of the scheme of the batch, or the default one if the scheme is unknown.
+func (p *BatchProof) Randomness() [][]byte {
+	randomness := crypto.RandomnessFromSignature
+	if sch, err := crypto.SchemeFromName(p.Scheme); err == nil {
+		randomness = sch.RandomnessFromSignature
+	}
+	out := make([][]byte, len(p.Beacons))
+	for i := range p.Beacons {
+		out[i] = randomness(p.Beacons[i].Signature)
+	}
+	return out
+}
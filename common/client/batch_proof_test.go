@@ -0,0 +1,70 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	clock "github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/v2/common/client"
+	"github.com/drand/drand/v2/crypto"
+	"github.com/drand/drand/v2/test/mock"
+)
+
+func TestBatchProof(t *testing.T) {
+	ctx := context.Background()
+	sch, err := crypto.GetSchemeFromEnv()
+	require.NoError(t, err)
+	s := mock.NewMockServer(t, false, sch, clock.NewFakeClock())
+	c := mock.NewGrpcClient(s.(*mock.Server))
+
+	// the mock server serves consecutive rounds starting at 1969
+	proof, err := client.NewBatchProof(ctx, c, 1969, 1970, 1971)
+	require.NoError(t, err)
+	require.Len(t, proof.Beacons, 3)
+	require.Equal(t, sch.Name, proof.Scheme)
+	info, err := c.Info(ctx)
+	require.NoError(t, err)
+	require.NoError(t, proof.Verify(info))
+	require.Len(t, proof.Randomness(), 3)
+
+	// the proof is meant to be handed over to a third party
+	data, err := json.Marshal(proof)
+	require.NoError(t, err)
+	received := new(client.BatchProof)
+	require.NoError(t, json.Unmarshal(data, received))
+	require.NoError(t, received.Verify(info))
+	require.Equal(t, proof.Randomness(), received.Randomness())
+
+	received.Beacons[1].Signature = received.Beacons[0].Signature
+	require.ErrorContains(t, received.Verify(info), "invalid round 1970")
+
+	_, err = client.NewBatchProof(ctx, c, 1900)
+	require.Error(t, err)
+}
+
+func TestBatchProofForgedForAnotherChain(t *testing.T) {
+	ctx := context.Background()
+	sch, err := crypto.GetSchemeFromEnv()
+	require.NoError(t, err)
+	s := mock.NewMockServer(t, false, sch, clock.NewFakeClock())
+	info, err := mock.NewGrpcClient(s.(*mock.Server)).Info(ctx)
+	require.NoError(t, err)
+
+	// a forger signs the rounds with its own key, which makes for a proof consistent with itself
+	forger := mock.NewMockServer(t, false, sch, clock.NewFakeClock())
+	forged, err := client.NewBatchProof(ctx, mock.NewGrpcClient(forger.(*mock.Server)), 1969, 1970)
+	require.NoError(t, err)
+	forgerInfo, err := mock.NewGrpcClient(forger.(*mock.Server)).Info(ctx)
+	require.NoError(t, err)
+	require.NoError(t, forged.Verify(forgerInfo))
+
+	// but not with the chain it claims to be for
+	require.ErrorContains(t, forged.Verify(info), "batch proof is for chain")
+	forged.ChainHash = info.Hash()
+	require.ErrorContains(t, forged.Verify(info), "public key")
+
+	require.Error(t, forged.Verify(nil))
+}
@@ -0,0 +1,82 @@
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+	"math"
+
+	"golang.org/x/crypto/chacha20"
+	"golang.org/x/crypto/hkdf"
+
+	"github.com/drand/drand/v2/crypto"
+)
+
+// randomnessStreamInfo separates the keys of the streams returned by Randomness.Reader from other uses of the
+// randomness of a round
+var randomnessStreamInfo = []byte("drand randomness stream v1")
+
+// Randomness derives values from the randomness of a round. The values only depend on the round, so that anybody
+// using the same round derives the same values, which is what fairness use cases such as lotteries need. It is not a
+// replacement for a CSPRNG: the randomness of a round is public once the round is emitted, and so are the values.
+type Randomness struct {
+	randomness []byte
+}
+
+// NewRandomness returns a Randomness for the given result of a chain of the given scheme. The randomness is derived
+// from the signature of the result with the digest of the scheme rather than taken from the result, so that the values
+// don't depend on the source of the result.
+func NewRandomness(r Result, sch *crypto.Scheme) *Randomness {
+	return &Randomness{randomness: sch.RandomnessFromSignature(r.GetSignature())}
+}
+
+// Uint64 returns the first 8 bytes of the randomness as a big endian integer
+func (r *Randomness) Uint64() uint64 {
+	var buf [8]byte
+	copy(buf[:], r.randomness)
+	return binary.BigEndian.Uint64(buf[:])
+}
+
+// IntN returns a uniformly distributed integer in [0, n), using rejection sampling over the stream of Reader to
+// avoid the modulo bias. It panics if n <= 0.
+func (r *Randomness) IntN(n int) int {
+	if n <= 0 {
+		panic("invalid argument to IntN")
+	}
+	bound := uint64(n)
+	// the largest multiple of bound that fits, values at or above it would be biased toward the small results
+	limit := math.MaxUint64 - math.MaxUint64%bound
+	stream := r.Reader()
+	var buf [8]byte
+	for {
+		// the stream never runs out
+		_, _ = io.ReadFull(stream, buf[:])
+		if v := binary.BigEndian.Uint64(buf[:]); v < limit {
+			return int(v % bound)
+		}
+	}
+}
+
+// Reader returns a deterministic stream of bytes keyed by the randomness: a ChaCha20 keystream whose key is derived
+// from the randomness with HKDF. Every call returns a new stream, starting from the beginning.
+func (r *Randomness) Reader() io.Reader {
+	key := make([]byte, chacha20.KeySize)
+	_, _ = io.ReadFull(hkdf.New(sha256.New, r.randomness, nil, randomnessStreamInfo), key)
+	cipher, err := chacha20.NewUnauthenticatedCipher(key, make([]byte, chacha20.NonceSize))
+	if err != nil {
+		// the key and nonce sizes are fixed
+		panic(err)
+	}
+	return &keystream{cipher: cipher}
+}
+
+// keystream is an io.Reader over the keystream of a ChaCha20 cipher
+type keystream struct {
+	cipher *chacha20.Cipher
+}
+
+func (k *keystream) Read(p []byte) (int, error) {
+	clear(p)
+	k.cipher.XORKeyStream(p, p)
+	return len(p), nil
+}
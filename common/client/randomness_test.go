@@ -0,0 +1,69 @@
+package client_test
+
+import (
+	"crypto/sha512"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/v2/common"
+	"github.com/drand/drand/v2/common/client"
+	"github.com/drand/drand/v2/crypto"
+)
+
+func TestRandomness(t *testing.T) {
+	sch, err := crypto.GetSchemeFromEnv()
+	require.NoError(t, err)
+	sig := []byte("not a real signature, but good enough to derive randomness")
+	b := &common.Beacon{Round: 12, Signature: sig}
+	r := client.NewRandomness(b, sch)
+
+	// the randomness is derived from the signature, whether the result carries it or not
+	require.Equal(t, r.Uint64(), client.NewRandomness(&noRandomness{round: 12, sig: sig}, sch).Uint64())
+	require.NotEqual(t, r.Uint64(), client.NewRandomness(&common.Beacon{Round: 13, Signature: []byte("other")}, sch).Uint64())
+
+	// with the digest of the scheme
+	custom := crypto.NewPedersenBLSUnchained()
+	custom.DigestFunc = sha512.New
+	digest := sha512.Sum512(sig)
+	require.Equal(t, binary.BigEndian.Uint64(digest[:8]), client.NewRandomness(b, custom).Uint64())
+
+	// the stream is deterministic and starts over on every call
+	first := make([]byte, 256)
+	_, err = io.ReadFull(r.Reader(), first)
+	require.NoError(t, err)
+	second := make([]byte, 256)
+	_, err = io.ReadFull(r.Reader(), second)
+	require.NoError(t, err)
+	require.Equal(t, first, second)
+
+	require.Equal(t, r.IntN(1000), r.IntN(1000))
+	require.Equal(t, 0, r.IntN(1))
+	require.Panics(t, func() { r.IntN(0) })
+
+	// IntN is roughly uniform across rounds
+	const n, draws = 6, 6000
+	counts := make([]int, n)
+	for i := 0; i < draws; i++ {
+		s := []byte{byte(i), byte(i >> 8)}
+		v := client.NewRandomness(&common.Beacon{Round: uint64(i), Signature: s}, sch).IntN(n)
+		require.GreaterOrEqual(t, v, 0)
+		require.Less(t, v, n)
+		counts[v]++
+	}
+	for _, c := range counts {
+		require.InDelta(t, draws/n, c, draws/n/5)
+	}
+}
+
+// noRandomness is a result that only carries its signature
+type noRandomness struct {
+	round uint64
+	sig   []byte
+}
+
+func (n *noRandomness) GetRound() uint64      { return n.round }
+func (n *noRandomness) GetRandomness() []byte { return nil }
+func (n *noRandomness) GetSignature() []byte  { return n.sig }
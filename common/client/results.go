@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/drand/drand/v2/common"
+)
+
+// ErrBeforeGenesis is returned by GetByTime for a time before the genesis of the chain, when no round exists yet
+var ErrBeforeGenesis = errors.New("time is before the genesis of the chain")
+
+// resultsConcurrency is how many rounds Results fetches ahead of the one it delivers
+const resultsConcurrency = 8
+
+// pendingResult is a round being fetched by Results
+type pendingResult struct {
+	round  uint64
+	result Result
+	err    error
+	done   chan struct{}
+}
+
+// Results streams the rounds from start to end included, in order, fetching them from c. Up to a few rounds are
+// fetched concurrently, but they are always delivered in sequence.
+// Both channels are closed once all the rounds are delivered, or as soon as fetching a round fails or the context
+// is canceled, in which case the error is sent on the error channel first. The results must be consumed for the
+// stream to make progress.
+func Results(ctx context.Context, c Client, start, end uint64) (<-chan Result, <-chan error) {
+	results := make(chan Result)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(results)
+		defer close(errs)
+
+		if start == 0 || end < start {
+			errs <- fmt.Errorf("invalid range of rounds [%d, %d]", start, end)
+			return
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		// the capacity of pending bounds how many rounds are fetched ahead of the one being delivered
+		pending := make(chan *pendingResult, resultsConcurrency)
+		go func() {
+			defer close(pending)
+			for round := start; ; round++ {
+				p := &pendingResult{round: round, done: make(chan struct{})}
+				select {
+				case pending <- p:
+				case <-ctx.Done():
+					return
+				}
+				go func() {
+					defer close(p.done)
+					p.result, p.err = c.Get(ctx, p.round)
+				}()
+				// we can't loop until round > end, in case end is the largest round
+				if round == end {
+					return
+				}
+			}
+		}()
+
+		var delivered bool
+		for p := range pending {
+			select {
+			case <-p.done:
+			case <-ctx.Done():
+				errs <- ctx.Err()
+				return
+			}
+			if p.err != nil {
+				errs <- fmt.Errorf("unable to get round %d: %w", p.round, p.err)
+				return
+			}
+			select {
+			case results <- p.result:
+				delivered = p.round == end
+			case <-ctx.Done():
+				errs <- ctx.Err()
+				return
+			}
+		}
+		// the rounds stop being fetched when the context is canceled
+		if !delivered {
+			errs <- ctx.Err()
+		}
+	}()
+
+	return results, errs
+}
+
+// GetByTime fetches from c the round that was the latest one at time t, i.e. the round emitted at or last before t.
+// It returns ErrBeforeGenesis if t is before the genesis time of the chain.
+func GetByTime(ctx context.Context, c Client, t time.Time) (Result, error) {
+	info, err := c.Info(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get chain info: %w", err)
+	}
+	if t.Unix() < info.GenesisTime {
+		return nil, fmt.Errorf("%w: %s is before %s", ErrBeforeGenesis,
+			t.UTC().Format(time.RFC3339), time.Unix(info.GenesisTime, 0).UTC().Format(time.RFC3339))
+	}
+	return c.Get(ctx, common.CurrentRound(t.Unix(), info.Period, info.GenesisTime))
+}
@@ -0,0 +1,117 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/v2/common"
+	"github.com/drand/drand/v2/common/chain"
+	"github.com/drand/drand/v2/common/client"
+)
+
+// roundsClient serves every round after a random delay, except failing ones
+type roundsClient struct {
+	info    *chain.Info
+	failing uint64
+}
+
+func (c *roundsClient) Get(ctx context.Context, round uint64) (client.Result, error) {
+	select {
+	case <-time.After(time.Duration(rand.Intn(5)) * time.Millisecond):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if round == c.failing {
+		return nil, errors.New("unavailable")
+	}
+	return &common.Beacon{Round: round, Signature: []byte{byte(round)}}, nil
+}
+
+func (c *roundsClient) Watch(context.Context) <-chan client.Result { return nil }
+func (c *roundsClient) Info(context.Context) (*chain.Info, error)  { return c.info, nil }
+func (c *roundsClient) RoundAt(time.Time) uint64                   { return 0 }
+func (c *roundsClient) Close() error                               { return nil }
+
+func TestResults(t *testing.T) {
+	ctx := context.Background()
+	c := &roundsClient{}
+
+	results, errs := client.Results(ctx, c, 10, 50)
+	next := uint64(10)
+	for r := range results {
+		require.Equal(t, next, r.GetRound())
+		next++
+	}
+	require.Equal(t, uint64(51), next)
+	require.NoError(t, <-errs)
+
+	// a single round
+	results, errs = client.Results(ctx, c, 7, 7)
+	r := <-results
+	require.Equal(t, uint64(7), r.GetRound())
+	_, ok := <-results
+	require.False(t, ok)
+	require.NoError(t, <-errs)
+
+	_, errs = client.Results(ctx, c, 10, 9)
+	require.Error(t, <-errs)
+}
+
+func TestResultsError(t *testing.T) {
+	c := &roundsClient{failing: 20}
+	results, errs := client.Results(context.Background(), c, 10, 50)
+	next := uint64(10)
+	for r := range results {
+		require.Equal(t, next, r.GetRound())
+		next++
+	}
+	// the rounds before the failing one are delivered
+	require.Equal(t, uint64(20), next)
+	require.ErrorContains(t, <-errs, "unable to get round 20")
+}
+
+func TestResultsCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	results, errs := client.Results(ctx, &roundsClient{}, 1, 1000)
+	r := <-results
+	require.Equal(t, uint64(1), r.GetRound())
+	cancel()
+	//nolint:revive // draining the results
+	for range results {
+	}
+	require.ErrorIs(t, <-errs, context.Canceled)
+}
+
+func TestGetByTime(t *testing.T) {
+	ctx := context.Background()
+	genesis := time.Unix(1_700_000_000, 0)
+	c := &roundsClient{info: &chain.Info{Period: 3 * time.Second, GenesisTime: genesis.Unix()}}
+
+	for _, tc := range []struct {
+		at    time.Duration
+		round uint64
+	}{
+		{0, 1},
+		{time.Second, 1},
+		{3*time.Second - time.Nanosecond, 1},
+		{3 * time.Second, 2},
+		{5 * time.Second, 2},
+		{30 * time.Second, 11},
+	} {
+		r, err := client.GetByTime(ctx, c, genesis.Add(tc.at))
+		require.NoError(t, err)
+		require.Equal(t, tc.round, r.GetRound(), "at genesis + %s", tc.at)
+	}
+
+	_, err := client.GetByTime(ctx, c, genesis.Add(-time.Second))
+	require.ErrorIs(t, err, client.ErrBeforeGenesis)
+
+	c.failing = 4
+	_, err = client.GetByTime(ctx, c, genesis.Add(10*time.Second))
+	require.Error(t, err)
+}
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/drand/drand/v2/common"
+	"github.com/drand/drand/v2/crypto"
+)
+
+// RoundVerificationError is returned by VerifyRange for the first round of the range that fails verification
+type RoundVerificationError struct {
+	Round uint64
+	Err   error
+}
+
+func (e *RoundVerificationError) Error() string {
+	return fmt.Sprintf("round %d failed verification: %v", e.Round, e.Err)
+}
+
+func (e *RoundVerificationError) Unwrap() error {
+	return e.Err
+}
+
+// VerifyRange fetches the rounds from start to end included from c, and checks their signature against the public
+// key of the chain c is connected to. For the chained schemes, it also checks that the previous signature of each
+// round is the signature of the round before it. It returns a *RoundVerificationError for the first round failing
+// verification, or the error of fetching the rounds.
+func VerifyRange(ctx context.Context, c Client, start, end uint64) error {
+	info, err := c.Info(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to get chain info: %w", err)
+	}
+	sch, err := crypto.SchemeFromName(info.Scheme)
+	if err != nil {
+		return err
+	}
+	chained := sch.Name == crypto.DefaultSchemeID
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	results, errs := Results(ctx, c, start, end)
+
+	// previous is the signature of the round before the one being verified, once known
+	var previous []byte
+	round := start
+	for res := range results {
+		b := &common.Beacon{Round: round, Signature: res.GetSignature()}
+		if res.GetRound() != round {
+			return &RoundVerificationError{Round: round, Err: fmt.Errorf("got round %d instead", res.GetRound())}
+		}
+
+		if chained {
+			if previous == nil && round == 1 {
+				// the genesis beacon, whose signature is the genesis seed, comes before round 1
+				previous = info.GenesisSeed
+			}
+			var carried []byte
+			if r, ok := res.(previousSignatureResult); ok {
+				carried = r.GetPreviousSignature()
+			}
+			switch {
+			case previous == nil:
+				b.PreviousSig, err = previousSignature(ctx, c, res)
+				if err != nil {
+					return err
+				}
+			case len(carried) > 0 && !bytes.Equal(carried, previous):
+				return &RoundVerificationError{
+					Round: round,
+					Err:   fmt.Errorf("previous signature isn't the signature of round %d", round-1),
+				}
+			default:
+				b.PreviousSig = previous
+			}
+		}
+
+		if err := sch.VerifyBeacon(b, info.PublicKey); err != nil {
+			return &RoundVerificationError{Round: round, Err: err}
+		}
+		previous = b.Signature
+		round++
+	}
+
+	// the error channel only gets nil once every round of the range was delivered
+	return <-errs
+}
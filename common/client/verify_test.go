@@ -0,0 +1,105 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/v2/common"
+	"github.com/drand/drand/v2/common/chain"
+	"github.com/drand/drand/v2/common/client"
+	"github.com/drand/drand/v2/crypto"
+	"github.com/drand/kyber/share"
+	"github.com/drand/kyber/sign/tbls"
+	"github.com/drand/kyber/util/random"
+)
+
+// signedChainClient serves a chain of validly signed rounds, which can be tampered with once generated
+type signedChainClient struct {
+	info    *chain.Info
+	beacons []*common.Beacon
+}
+
+func newSignedChainClient(t *testing.T, sch *crypto.Scheme, rounds int) *signedChainClient {
+	t.Helper()
+	secret := sch.KeyGroup.Scalar().Pick(random.New())
+	c := &signedChainClient{info: &chain.Info{
+		PublicKey:   sch.KeyGroup.Point().Mul(secret, nil),
+		Period:      time.Second,
+		Scheme:      sch.Name,
+		GenesisTime: time.Now().Unix(),
+		GenesisSeed: []byte("genesis seed"),
+	}}
+
+	previous := c.info.GenesisSeed
+	for round := 1; round <= rounds; round++ {
+		b := &common.Beacon{Round: uint64(round)}
+		if sch.Name == crypto.DefaultSchemeID {
+			b.PreviousSig = previous
+		}
+		sig, err := sch.ThresholdScheme.Sign(&share.PriShare{I: 0, V: secret}, sch.DigestBeacon(b))
+		require.NoError(t, err)
+		sigShare := tbls.SigShare(sig)
+		b.Signature = sigShare.Value()
+		c.beacons = append(c.beacons, b)
+		previous = b.Signature
+	}
+	return c
+}
+
+func (c *signedChainClient) Get(_ context.Context, round uint64) (client.Result, error) {
+	if round == 0 || round > uint64(len(c.beacons)) {
+		return nil, errors.New("unknown round")
+	}
+	return c.beacons[round-1], nil
+}
+
+func (c *signedChainClient) Watch(context.Context) <-chan client.Result { return nil }
+func (c *signedChainClient) Info(context.Context) (*chain.Info, error)  { return c.info, nil }
+func (c *signedChainClient) RoundAt(time.Time) uint64                   { return 0 }
+func (c *signedChainClient) Close() error                               { return nil }
+
+func TestVerifyRange(t *testing.T) {
+	ctx := context.Background()
+	for _, schemeID := range crypto.ListSchemes() {
+		t.Run(schemeID, func(t *testing.T) {
+			sch, err := crypto.SchemeFromName(schemeID)
+			require.NoError(t, err)
+			c := newSignedChainClient(t, sch, 10)
+
+			require.NoError(t, client.VerifyRange(ctx, c, 1, 10))
+			// a range not starting at genesis needs the round before it for the chained schemes
+			require.NoError(t, client.VerifyRange(ctx, c, 4, 7))
+
+			// fetching the rounds fails beyond the chain
+			err = client.VerifyRange(ctx, c, 8, 12)
+			var verr *client.RoundVerificationError
+			require.False(t, errors.As(err, &verr))
+			require.ErrorContains(t, err, "unable to get round 11")
+
+			// the first invalid round is reported
+			c.beacons[5].Signature, c.beacons[7].Signature = c.beacons[7].Signature, c.beacons[5].Signature
+			err = client.VerifyRange(ctx, c, 1, 10)
+			require.ErrorAs(t, err, &verr)
+			require.Equal(t, uint64(6), verr.Round)
+		})
+	}
+}
+
+func TestVerifyRangeChecksPreviousSignatures(t *testing.T) {
+	ctx := context.Background()
+	sch, err := crypto.SchemeFromName(crypto.DefaultSchemeID)
+	require.NoError(t, err)
+	c := newSignedChainClient(t, sch, 5)
+
+	// round 4 doesn't link to round 3 anymore
+	c.beacons[3].PreviousSig = c.beacons[1].Signature
+	err = client.VerifyRange(ctx, c, 1, 5)
+	var verr *client.RoundVerificationError
+	require.ErrorAs(t, err, &verr)
+	require.Equal(t, uint64(4), verr.Round)
+	require.ErrorContains(t, err, "previous signature isn't the signature of round 3")
+}
@@ -225,6 +225,55 @@ type GroupTOML struct {
 	ID             string
 }
 
+// validate checks the fields of a group file before it is decoded by Load, and reports all the problems found at
+// once, naming the offending fields, since group files may be edited by hand
+func (gt *GroupTOML) validate() error {
+	var errs []error
+	n := len(gt.Nodes)
+	if n == 0 {
+		errs = append(errs, errors.New("Nodes: the group has no nodes"))
+	} else if gt.Threshold < dkg.MinimumT(n) || gt.Threshold > n {
+		errs = append(errs, fmt.Errorf("Threshold: %d is invalid, it must be between %d and %d for %d nodes",
+			gt.Threshold, dkg.MinimumT(n), n, n))
+	}
+
+	if gt.Period == "" {
+		errs = append(errs, errors.New("Period: missing"))
+	} else if period, err := time.ParseDuration(gt.Period); err != nil {
+		errs = append(errs, fmt.Errorf("Period: %q is not a duration such as \"30s\"", gt.Period))
+	} else if period <= 0 {
+		errs = append(errs, fmt.Errorf("Period: %s must be positive", gt.Period))
+	}
+	if gt.CatchupPeriod != "" {
+		if catchup, err := time.ParseDuration(gt.CatchupPeriod); err != nil || catchup < 0 {
+			errs = append(errs, fmt.Errorf("CatchupPeriod: %q is not a positive duration such as \"1s\"", gt.CatchupPeriod))
+		}
+	}
+	if gt.GenesisTime == 0 {
+		errs = append(errs, errors.New("GenesisTime: missing"))
+	}
+
+	addresses := make(map[string]int)
+	for i, node := range gt.Nodes {
+		if node == nil || node.PublicTOML == nil {
+			errs = append(errs, fmt.Errorf("Nodes[%d]: empty node", i))
+			continue
+		}
+		if node.Address == "" {
+			errs = append(errs, fmt.Errorf("Nodes[%d].Address: missing", i))
+		} else if j, exists := addresses[node.Address]; exists {
+			errs = append(errs, fmt.Errorf("Nodes[%d].Address: %s is already the address of Nodes[%d]", i, node.Address, j))
+		} else {
+			addresses[node.Address] = i
+		}
+		if node.Key == "" {
+			errs = append(errs, fmt.Errorf("Nodes[%d].Key: missing", i))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 //nolint:gocyclo
 func (g *Group) FromTOML(i interface{}) error {
 	if i == nil {
@@ -3,6 +3,8 @@ package key
 import (
 	"bytes"
 	"os"
+	"path"
+	"strconv"
 	"testing"
 	"time"
 
@@ -19,7 +21,7 @@ import (
 func newIds(t *testing.T, n int) []*Node {
 	ids := make([]*Node, n)
 	for i := 0; i < n; i++ {
-		key, err := NewKeyPair("127.0.0.1:3000", nil)
+		key, err := NewKeyPair("127.0.0.1:"+strconv.Itoa(3000+i), nil)
 		require.NoError(t, err)
 
 		ids[i] = &Node{
@@ -281,3 +283,45 @@ ID = "default"
 	// even though there are 12 indexes, we expect the len to be 10 as some are missing
 	require.Equal(t, 8, g.Len())
 }
+
+func TestLoadGroupValidation(t *testing.T) {
+	ids := newIds(t, 3)
+	sch, err := crypto.GetSchemeFromEnv()
+	require.NoError(t, err)
+	group := &Group{
+		Threshold:   2,
+		Period:      30 * time.Second,
+		GenesisTime: time.Now().Unix(),
+		Nodes:       ids,
+		Scheme:      sch,
+		ID:          "test_beacon",
+	}
+	load := func(gt *GroupTOML) error {
+		groupPath := path.Join(t.TempDir(), "group.toml")
+		f, err := os.Create(groupPath)
+		require.NoError(t, err)
+		require.NoError(t, toml.NewEncoder(f).Encode(gt))
+		require.NoError(t, f.Close())
+		return Load(groupPath, new(Group))
+	}
+	require.NoError(t, load(group.TOML().(*GroupTOML)))
+
+	gt := group.TOML().(*GroupTOML)
+	gt.Threshold = 4
+	gt.Period = "30"
+	gt.GenesisTime = 0
+	gt.Nodes[2].Address = gt.Nodes[0].Address
+	gt.Nodes[1].Key = ""
+
+	// every problem is reported, naming the offending field
+	err = load(gt)
+	require.ErrorContains(t, err, "Threshold: 4 is invalid, it must be between 2 and 3 for 3 nodes")
+	require.ErrorContains(t, err, `Period: "30" is not a duration`)
+	require.ErrorContains(t, err, "GenesisTime: missing")
+	require.ErrorContains(t, err, "Nodes[2].Address: "+gt.Nodes[0].Address+" is already the address of Nodes[0]")
+	require.ErrorContains(t, err, "Nodes[1].Key: missing")
+
+	gt = group.TOML().(*GroupTOML)
+	gt.Period = "-1s"
+	require.ErrorContains(t, load(gt), "Period: -1s must be positive")
+}
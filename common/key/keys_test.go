@@ -6,6 +6,7 @@ import (
 	"os"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/BurntSushi/toml"
 	"github.com/stretchr/testify/require"
@@ -163,10 +164,12 @@ func BatchIdentities(t *testing.T, n int) ([]*Pair, *Group) {
 	fakeDistKey := sch.KeyGroup.Point().Pick(random.New())
 	distKey := &DistPublic{Coefficients: []kyber.Point{fakeDistKey}}
 	group := &Group{
-		Threshold: DefaultThreshold(n),
-		Nodes:     pubs,
-		PublicKey: distKey,
-		Scheme:    sch,
+		Threshold:   DefaultThreshold(n),
+		Period:      30 * time.Second,
+		GenesisTime: time.Now().Unix(),
+		Nodes:       pubs,
+		PublicKey:   distKey,
+		Scheme:      sch,
 	}
 	return privs, group
 }
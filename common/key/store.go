@@ -202,9 +202,20 @@ func Load(filePath string, t Tomler) error {
 	if _, err = toml.DecodeFile(filePath, tomlValue); err != nil {
 		return err
 	}
+	if v, ok := tomlValue.(tomlValidator); ok {
+		if err := v.validate(); err != nil {
+			return fmt.Errorf("invalid file %s: %w", filePath, err)
+		}
+	}
 	return t.FromTOML(tomlValue)
 }
 
+// tomlValidator is implemented by the TOML values of the files that operators may edit by hand, which Load
+// validates before decoding them
+type tomlValidator interface {
+	validate() error
+}
+
 // Delete the resource denoted by the given path. If it is a file, it deletes
 // the file; if it is a folder it delete the folder and all its content.
 func Delete(filePath string) error {
@@ -13,6 +13,7 @@ import (
 
 	"github.com/drand/kyber"
 	bls "github.com/drand/kyber-bls12381"
+	"github.com/drand/kyber/pairing"
 	bn254 "github.com/drand/kyber/pairing/bn254"
 	"github.com/drand/kyber/sign"
 
@@ -52,6 +53,8 @@ type Scheme struct {
 	SigGroup kyber.Group
 	// KeyGroup is the group used to create the keys
 	KeyGroup kyber.Group
+	// Pairing is the pairing suite both groups come from
+	Pairing pairing.Suite `toml:"-"`
 	// ThresholdScheme is the signature scheme used, defining over which curve the signature
 	// and keys respectively are.
 	ThresholdScheme sign.ThresholdScheme
@@ -63,6 +66,9 @@ type Scheme struct {
 	IdentityHash func() hash.Hash `toml:"-"`
 	// the DigestBeacon is used to generate the bytes that are getting signed
 	DigestBeacon func(hashableBeacon) []byte `toml:"-"`
+	// DigestFunc is the hash function the randomness of a round is derived from its signature with. The message
+	// signed is built by DigestBeacon, which may hash with another function, e.g. keccak256 on BN254.
+	DigestFunc func() hash.Hash `toml:"-"`
 }
 
 // VerifyBeacon is verifying the aggregated beacon against the provided group public key
@@ -70,6 +76,23 @@ func (s *Scheme) VerifyBeacon(b SignedBeacon, pubkey kyber.Point) error {
 	return s.ThresholdScheme.VerifyRecovered(pubkey, s.DigestBeacon(b), b.GetSignature())
 }
 
+// RandomnessFromSignature derives the round randomness from its signature with the DigestFunc of the scheme,
+// defaulting to the sha256 hash all the schemes defined here use.
+func (s *Scheme) RandomnessFromSignature(sig []byte) []byte {
+	if s.DigestFunc == nil {
+		return RandomnessFromSignature(sig)
+	}
+	h := s.DigestFunc()
+	_, _ = h.Write(sig)
+	return h.Sum(nil)
+}
+
+// SupportsBatchVerification returns true if the beacons of this scheme can be verified all at once. Only unchained
+// schemes do, since the beacons of a chained scheme are only valid if they also link to the previous ones.
+func (s *Scheme) SupportsBatchVerification() bool {
+	return s.Name != DefaultSchemeID
+}
+
 func (s *Scheme) String() string {
 	if s != nil {
 		return s.Name
@@ -120,11 +143,13 @@ func NewPedersenBLSChained() (cs *Scheme) {
 		Name:            DefaultSchemeID,
 		SigGroup:        SigGroup,
 		KeyGroup:        KeyGroup,
+		Pairing:         Pairing,
 		ThresholdScheme: ThresholdScheme,
 		AuthScheme:      AuthScheme,
 		DKGAuthScheme:   DKGAuthScheme,
 		IdentityHash:    IdentityHashFunc,
 		DigestBeacon:    DigestFunc,
+		DigestFunc:      sha256.New,
 	}
 }
 
@@ -157,11 +182,13 @@ func NewPedersenBLSUnchained() (cs *Scheme) {
 		Name:            UnchainedSchemeID,
 		SigGroup:        SigGroup,
 		KeyGroup:        KeyGroup,
+		Pairing:         Pairing,
 		ThresholdScheme: ThresholdScheme,
 		AuthScheme:      AuthScheme,
 		DKGAuthScheme:   DKGAuthScheme,
 		IdentityHash:    IdentityHashFunc,
 		DigestBeacon:    DigestFunc,
+		DigestFunc:      sha256.New,
 	}
 }
 
@@ -201,11 +228,13 @@ func NewPedersenBLSUnchainedSwapped() (cs *Scheme) {
 		Name:            ShortSigSchemeID,
 		SigGroup:        SigGroup,
 		KeyGroup:        KeyGroup,
+		Pairing:         Pairing,
 		ThresholdScheme: ThresholdScheme,
 		AuthScheme:      AuthScheme,
 		DKGAuthScheme:   DKGAuthScheme,
 		IdentityHash:    IdentityHashFunc,
 		DigestBeacon:    DigestFunc,
+		DigestFunc:      sha256.New,
 	}
 }
 
@@ -242,11 +271,13 @@ func NewPedersenBLSUnchainedG1() (cs *Scheme) {
 		Name:            SigsOnG1ID,
 		SigGroup:        SigGroup,
 		KeyGroup:        KeyGroup,
+		Pairing:         Pairing,
 		ThresholdScheme: ThresholdScheme,
 		AuthScheme:      AuthScheme,
 		DKGAuthScheme:   DKGAuthScheme,
 		IdentityHash:    IdentityHashFunc,
 		DigestBeacon:    DigestFunc,
+		DigestFunc:      sha256.New,
 	}
 }
 
@@ -283,11 +314,13 @@ func NewPedersenBLSBN254UnchainedOnG1Scheme() (cs *Scheme) {
 		Name:            BN254UnchainedOnG1SchemeID,
 		SigGroup:        SigGroup,
 		KeyGroup:        KeyGroup,
+		Pairing:         Pairing,
 		ThresholdScheme: ThresholdScheme,
 		AuthScheme:      AuthScheme,
 		DKGAuthScheme:   DKGAuthScheme,
 		IdentityHash:    IdentityHashFunc,
 		DigestBeacon:    DigestFunc,
+		DigestFunc:      sha256.New,
 	}
 }
 
@@ -334,10 +367,11 @@ func GetSchemeFromEnv() (*Scheme, error) {
 	return GetSchemeByID(id)
 }
 
-// RandomnessFromSignature derives the round randomness from its signature. We are using sha256 currently
-// but it could use blake2b instead or another hash. Hashing the signature is important because the algebraic structure
-// of the elliptic curve points that correspond to signatures does not map uniformly with all possible bit string, but
-// a signature is indistinguishable from any random point on that elliptic curve.
+// RandomnessFromSignature derives the round randomness from its signature. We are using sha256 currently for all
+// schemes, a scheme using another hash sets its DigestFunc and goes through Scheme.RandomnessFromSignature instead.
+// Hashing the signature is important because the algebraic structure of the elliptic curve points that correspond
+// to signatures does not map uniformly with all possible bit string, but a signature is indistinguishable from any
+// random point on that elliptic curve.
 func RandomnessFromSignature(sig []byte) []byte {
 	out := sha256.Sum256(sig)
 	return out[:]
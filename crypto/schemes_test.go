@@ -1,6 +1,7 @@
 package crypto_test
 
 import (
+	"crypto/sha512"
 	"encoding/hex"
 	"testing"
 
@@ -205,3 +206,21 @@ func TestGetSchemeByID(t *testing.T) {
 		})
 	}
 }
+
+func TestRandomnessFromSignature(t *testing.T) {
+	sig := []byte("a signature")
+	for _, name := range crypto.ListSchemes() {
+		sch, err := crypto.SchemeFromName(name)
+		require.NoError(t, err)
+		// the existing chains all derive their randomness with sha256
+		require.Equal(t, crypto.RandomnessFromSignature(sig), sch.RandomnessFromSignature(sig), name)
+	}
+
+	sch := crypto.NewPedersenBLSUnchained()
+	sch.DigestFunc = sha512.New
+	expected := sha512.Sum512(sig)
+	require.Equal(t, expected[:], sch.RandomnessFromSignature(sig))
+
+	sch.DigestFunc = nil
+	require.Equal(t, crypto.RandomnessFromSignature(sig), sch.RandomnessFromSignature(sig))
+}
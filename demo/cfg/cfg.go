@@ -1,6 +1,9 @@
 package cfg
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/drand/drand/v2/crypto"
 	"github.com/drand/drand/v2/internal/chain"
 )
@@ -21,4 +24,38 @@ type Config struct {
 	MemDBSize    int
 	Offset       int
 	BasePath     string
+	// Hosts are the remote hosts the subprocess nodes run on, in turn. The nodes run locally when it's empty.
+	Hosts []Host
+}
+
+// Host is a remote host running drand nodes. The orchestrator runs their daemons over ssh, with the drand binary it
+// uploads, for which the host must have the same platform as the orchestrator. The ports of the nodes are picked
+// locally and must be free on the host too.
+type Host struct {
+	// SSH is the destination of the host for ssh and scp, e.g. user@10.0.0.2 or a host of the ssh config
+	SSH string
+	// Addr is the address the nodes of the host are reached at, by the other nodes and the orchestrator
+	Addr string
+}
+
+// ParseHosts parses a comma separated list of hosts, each given as its ssh destination, optionally followed by "="
+// and its address when the nodes aren't reached at the host name of the destination, e.g.
+// "user@10.0.0.2,bastion-node=192.168.1.3".
+func ParseHosts(s string) ([]Host, error) {
+	var hosts []Host
+	for _, h := range strings.Split(s, ",") {
+		h = strings.TrimSpace(h)
+		if h == "" {
+			continue
+		}
+		dest, addr, found := strings.Cut(h, "=")
+		if !found {
+			addr = dest[strings.LastIndex(dest, "@")+1:]
+		}
+		if dest == "" || addr == "" {
+			return nil, fmt.Errorf("invalid host %q", h)
+		}
+		hosts = append(hosts, Host{SSH: dest, Addr: addr})
+	}
+	return hosts, nil
 }
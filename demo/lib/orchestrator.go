@@ -1,7 +1,6 @@
 package lib
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -17,6 +16,7 @@ import (
 	json "github.com/nikkolasg/hexjson"
 
 	"github.com/drand/drand/v2/common"
+	public "github.com/drand/drand/v2/common/chain"
 	"github.com/drand/drand/v2/common/key"
 	"github.com/drand/drand/v2/crypto"
 	"github.com/drand/drand/v2/demo/cfg"
@@ -58,6 +58,7 @@ type Orchestrator struct {
 	dbEngineType      chain.StorageType
 	pgDSN             func() string
 	memDBSize         int
+	hosts             []cfg.Host
 }
 
 func NewOrchestrator(c cfg.Config) *Orchestrator {
@@ -90,6 +91,7 @@ func NewOrchestrator(c cfg.Config) *Orchestrator {
 		dbEngineType:      c.DBEngineType,
 		pgDSN:             c.PgDSN,
 		memDBSize:         c.MemDBSize,
+		hosts:             c.Hosts,
 	}
 	return e
 }
@@ -271,6 +273,15 @@ func (e *Orchestrator) CheckNewBeacon(exclude ...int) {
 	e.checkBeaconNodes(filtered, e.newGroupPath, e.withCurl)
 }
 
+// toBeacon returns the beacon served in a public randomness response
+func toBeacon(r *drand.PublicRandResponse) *common.Beacon {
+	return &common.Beacon{
+		Round:       r.GetRound(),
+		Signature:   r.GetSignature(),
+		PreviousSig: r.GetPreviousSignature(),
+	}
+}
+
 func filterNodes(list []node.Node, exclude ...int) []node.Node {
 	var filtered []node.Node
 	for _, n := range list {
@@ -318,9 +329,9 @@ func (e *Orchestrator) checkBeaconNodes(nodes []node.Node, group string, tryCurl
 				// we try again
 				continue
 			}
-			// then we check if the signatures match
-			if !bytes.Equal(randResp.GetSignature(), pubRand.GetSignature()) {
-				panic("\t\t[-] Inconsistent beacon signature between nodes")
+			// then we check if the beacons match
+			if err := public.AssertConsistent(toBeacon(pubRand), toBeacon(randResp)); err != nil {
+				panic(fmt.Sprintf("\t\t[-] Inconsistent beacon between node %d and node %d: %v", lastIndex, n.Index(), err))
 			}
 			// everything is good
 			fmt.Println("\t\t[-] attempt", i+1, "SUCCESS")
@@ -361,7 +372,7 @@ func (e *Orchestrator) checkBeaconNodes(nodes []node.Node, group string, tryCurl
 				checkErr(json.Unmarshal(out, r), string(out))
 				if r.GetRound() != pubRand.GetRound() {
 					panic("[-] Inconsistent round from curl vs CLI")
-				} else if !bytes.Equal(r.GetSignature(), pubRand.GetSignature()) {
+				} else if public.AssertConsistent(toBeacon(pubRand), toBeacon(r)) != nil {
 					fmt.Printf("curl output: %s\n", out)
 					if !strings.Contains(string(out), "round") ||
 						!strings.Contains(string(out), "randomness") ||
@@ -398,6 +409,7 @@ func (e *Orchestrator) SetupNewNodes(n int) {
 		DBEngineType: e.dbEngineType,
 		PgDSN:        e.pgDSN,
 		MemDBSize:    e.memDBSize,
+		Hosts:        e.hosts,
 	}
 
 	e.newNodes, e.newPaths = createNodes(c)
@@ -559,6 +571,8 @@ func createNodes(cfg cfg.Config) ([]node.Node, []string) {
 		var n node.Node
 		if cfg.Binary != "" {
 			n = node.NewNode(idx, cfg)
+		} else if len(cfg.Hosts) > 0 {
+			panic("nodes can only run on remote hosts as subprocesses, with a binary")
 		} else {
 			n = node.NewLocalNode(idx, "127.0.0.1", cfg)
 		}
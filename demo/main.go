@@ -33,6 +33,8 @@ var testF = flag.Bool("test", false, "Run it as a test that finishes.")
 var noCurl = flag.Bool("nocurl", false, "Skip commands using curl.")
 var debug = flag.Bool("debug", false, "Prints the log when panic occurs.")
 var dbEngineType = flag.String("dbtype", "bolt", "Which database engine to use. Supported values: bolt, postgres, or memdb.")
+var hostsF = flag.String("hosts", "", "Comma separated ssh destinations of remote hosts to run the nodes on, "+
+	"each optionally followed by =<address of its nodes>. The nodes run locally by default.")
 
 func main() {
 	flag.Parse()
@@ -60,6 +62,8 @@ func main() {
 		panic(err)
 	}
 	beaconID := test.GetBeaconIDFromEnv()
+	hosts, err := cfg.ParseHosts(*hostsF)
+	checkErr(err)
 
 	c := cfg.Config{
 		N:            n,
@@ -73,6 +77,7 @@ func main() {
 		DBEngineType: chain.StorageType(*dbEngineType),
 		PgDSN:        cfg.ComputePgDSN(chain.StorageType(*dbEngineType)),
 		MemDBSize:    2000,
+		Hosts:        hosts,
 	}
 	orch := lib.NewOrchestrator(c)
 	// NOTE: this line should be before "StartNewNodes". The reason it is here
@@ -324,7 +324,7 @@ func (l *LocalNode) GetBeacon(_ string, round uint64) (ret *drand.PublicRandResp
 	ret = &drand.PublicRandResponse{
 		Round:      r.GetRound(),
 		Signature:  r.GetSignature(),
-		Randomness: r.GetRandomness(),
+		Randomness: r.RandomnessFor(l.scheme),
 	}
 	return
 }
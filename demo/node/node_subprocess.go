@@ -17,6 +17,7 @@ import (
 	clock "github.com/jonboulle/clockwork"
 	json "github.com/nikkolasg/hexjson"
 
+	"github.com/drand/drand/v2/common"
 	"github.com/drand/drand/v2/common/key"
 	"github.com/drand/drand/v2/common/log"
 	"github.com/drand/drand/v2/crypto"
@@ -51,6 +52,8 @@ type NodeProc struct {
 	scheme       *crypto.Scheme
 	beaconID     string
 	dkgRunner    *dkg.TestRunner
+	// remote is set for the nodes running on a remote host
+	remote *remote
 
 	dbEngineType chain.StorageType
 	memDBSize    int
@@ -86,6 +89,10 @@ func NewNode(i int, cfg cfg.Config) *NodeProc {
 		pgDSN:        cfg.PgDSN(),
 		memDBSize:    cfg.MemDBSize,
 	}
+	if len(cfg.Hosts) > 0 {
+		n.remote = newRemote(cfg.Hosts[i%len(cfg.Hosts)], nbase)
+		n.remote.upload(cfg.Binary, nbase)
+	}
 	n.setup()
 	return n
 }
@@ -94,6 +101,18 @@ func NewNode(i int, cfg cfg.Config) *NodeProc {
 func (n *NodeProc) UpdateBinary(binary string, isCandidate bool) {
 	n.binary = binary
 	n.isCandidate = isCandidate
+	if n.remote != nil {
+		n.remote.upload(binary, n.base)
+	}
+}
+
+// daemonCommand makes the command running the drand binary where the daemon of the node runs, i.e. on its host for
+// remote nodes. The control commands always run locally.
+func (n *NodeProc) daemonCommand(ctx context.Context, args ...string) *exec.Cmd {
+	if n.remote != nil {
+		return n.remote.command(ctx, true, n.remote.binary, args...)
+	}
+	return exec.CommandContext(ctx, n.binary, args...)
 }
 
 func (n *NodeProc) setup() {
@@ -102,6 +121,9 @@ func (n *NodeProc) setup() {
 	freePort := test.FreePort()
 	freePortREST := test.FreePort()
 	host := "127.0.0.1"
+	if n.remote != nil {
+		host = n.remote.host.Addr
+	}
 	n.privAddr = host + ":" + freePort
 	n.pubAddr = host + ":" + freePortREST
 	ctrlPort := test.FreePort()
@@ -124,10 +146,13 @@ func (n *NodeProc) setup() {
 	args := []string{"generate-keypair", "--folder", n.base, "--id", n.beaconID, "--scheme", n.scheme.Name}
 
 	args = append(args, n.privAddr)
-	newKey := exec.Command(n.binary, args...)
+	newKey := n.daemonCommand(context.Background(), args...)
 	runCommand(newKey)
 
 	config := core.NewConfig(n.lg, core.WithConfigFolder(n.base))
+	if n.remote != nil {
+		n.remote.fetch(config.ConfigFolderMB())
+	}
 	n.store = key.NewFileStore(config.ConfigFolderMB(), n.beaconID)
 
 	// verify it's done
@@ -175,10 +200,16 @@ func (n *NodeProc) Start(dbEngineType chain.StorageType, pgDSN func() string, me
 
 	fmt.Printf("starting node %s with cmd: %s \n", n.privAddr, args)
 
+	if n.remote != nil {
+		if err := n.remote.openTunnel(n.ctrl); err != nil {
+			return err
+		}
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	n.cancel = cancel
 
-	cmd := exec.CommandContext(ctx, n.binary, args...)
+	cmd := n.daemonCommand(ctx, args...)
 	n.startCmd = cmd
 	cmd.Stdout = logFile
 	cmd.Stderr = logFile
@@ -337,6 +368,9 @@ func (n *NodeProc) WaitDKGComplete(epoch uint32, timeout time.Duration) (*key.Gr
 	if err != nil {
 		return nil, err
 	}
+	if n.remote != nil {
+		n.remote.fetch(path.Join(n.base, common.MultiBeaconFolder))
+	}
 	return n.store.LoadGroup()
 }
 
@@ -423,6 +457,9 @@ func (n *NodeProc) Stop() {
 			continue
 		}
 		fmt.Printf("\t + node successfully shutdown\n")
+		if n.remote != nil {
+			n.remote.closeTunnel()
+		}
 		return
 	}
 	panic("node should have stopped but is still running")
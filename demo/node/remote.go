@@ -0,0 +1,85 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"path"
+	"strings"
+
+	"github.com/drand/drand/v2/demo/cfg"
+)
+
+// remote runs the daemon of a node on a remote host, over ssh. The node keeps the same folder on its host as
+// locally, and its control port is forwarded to the same local port, so that the control commands, which read
+// their files locally, run as they do for local nodes.
+type remote struct {
+	host cfg.Host
+	// binary is the path of the drand binary uploaded on the host
+	binary string
+	tunnel *exec.Cmd
+}
+
+func newRemote(host cfg.Host, base string) *remote {
+	r := &remote{host: host}
+	// start from a clean folder, as the orchestrator does locally
+	runCommand(r.command(context.Background(), false, "rm", "-rf", base))
+	runCommand(r.command(context.Background(), false, "mkdir", "-p", base))
+	return r
+}
+
+// command makes the command running name with the given arguments on the host. With tty, the remote process is
+// killed once the command is.
+func (r *remote) command(ctx context.Context, tty bool, name string, args ...string) *exec.Cmd {
+	sshArgs := []string{"-o", "BatchMode=yes"}
+	if tty {
+		sshArgs = append(sshArgs, "-tt")
+	}
+	sshArgs = append(sshArgs, r.host.SSH, "--", shellQuote(name))
+	for _, arg := range args {
+		sshArgs = append(sshArgs, shellQuote(arg))
+	}
+	return exec.CommandContext(ctx, "ssh", sshArgs...)
+}
+
+// upload copies the given binary to the folder of the node on the host, for the node to run it
+func (r *remote) upload(binary, base string) {
+	// the binary can be given by name, to be found in the PATH
+	local, err := exec.LookPath(binary)
+	checkErr(err)
+	r.binary = path.Join(base, path.Base(local))
+	runCommand(exec.Command("scp", "-q", "-o", "BatchMode=yes", local, r.host.SSH+":"+r.binary))
+}
+
+// fetch copies back the given folder of the host to the same local path
+func (r *remote) fetch(folder string) {
+	runCommand(exec.Command("scp", "-q", "-r", "-o", "BatchMode=yes", r.host.SSH+":"+folder, path.Dir(folder)))
+}
+
+// openTunnel forwards the given local port to the same port on the host
+func (r *remote) openTunnel(port string) error {
+	if r.tunnel != nil {
+		return nil
+	}
+	r.tunnel = exec.Command("ssh", "-N", "-o", "BatchMode=yes", "-o", "ExitOnForwardFailure=yes",
+		"-L", fmt.Sprintf("127.0.0.1:%s:127.0.0.1:%s", port, port), r.host.SSH)
+	if err := r.tunnel.Start(); err != nil {
+		r.tunnel = nil
+		return fmt.Errorf("unable to forward the control port to %s: %w", r.host.SSH, err)
+	}
+	return nil
+}
+
+func (r *remote) closeTunnel() {
+	if r.tunnel == nil {
+		return
+	}
+	_ = r.tunnel.Process.Kill()
+	_ = r.tunnel.Wait()
+	r.tunnel = nil
+}
+
+// shellQuote quotes s for the remote shell ssh runs the commands with
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
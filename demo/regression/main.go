@@ -33,6 +33,8 @@ type regressionErrors struct {
 var build = flag.String("release", "drand", "path to base build")
 var candidate = flag.String("candidate", "drand", "path to candidate build")
 var dbEngineType = flag.String("db", "bolt", "Which database engine to use. Supported values: bolt, postgres, or memdb.")
+var hostsF = flag.String("hosts", "", "Comma separated ssh destinations of remote hosts to run the nodes on, "+
+	"each optionally followed by =<address of its nodes>. The nodes run locally by default.")
 
 func testStartup(orch *lib.Orchestrator) (err error) {
 	defer func() {
@@ -110,13 +112,17 @@ func main() {
 		panic(err)
 	}
 	beaconID := test.GetBeaconIDFromEnv()
+	hosts, err := cfg.ParseHosts(*hostsF)
+	if err != nil {
+		panic(err)
+	}
 
 	if chain.StorageType(*dbEngineType) == chain.PostgreSQL {
 		stopContainer := cfg.BootContainer()
 		defer stopContainer()
 	}
 
-	c := computeConfig(n, thr, period, sch, beaconID)
+	c := computeConfig(n, thr, period, sch, beaconID, hosts)
 	orch := lib.NewOrchestrator(c)
 	orch.UpdateBinary(*candidate, 2, true)
 
@@ -151,6 +157,7 @@ func main() {
 			DBEngineType: chain.StorageType(*dbEngineType),
 			PgDSN:        cfg.ComputePgDSN(chain.StorageType(*dbEngineType)),
 			MemDBSize:    2000,
+			Hosts:        hosts,
 		}
 		orch = lib.NewOrchestrator(c)
 
@@ -189,6 +196,7 @@ func main() {
 			DBEngineType: chain.StorageType(*dbEngineType),
 			PgDSN:        cfg.ComputePgDSN(chain.StorageType(*dbEngineType)),
 			MemDBSize:    2000,
+			Hosts:        hosts,
 		}
 		orch = lib.NewOrchestrator(c)
 
@@ -216,7 +224,7 @@ func main() {
 	}
 }
 
-func computeConfig(n int, thr int, period string, sch *crypto.Scheme, beaconID string) cfg.Config {
+func computeConfig(n int, thr int, period string, sch *crypto.Scheme, beaconID string, hosts []cfg.Host) cfg.Config {
 	return cfg.Config{
 		N:            n,
 		Thr:          thr,
@@ -229,6 +237,7 @@ func computeConfig(n int, thr int, period string, sch *crypto.Scheme, beaconID s
 		DBEngineType: chain.StorageType(*dbEngineType),
 		PgDSN:        cfg.ComputePgDSN(chain.StorageType(*dbEngineType)),
 		MemDBSize:    2000,
+		Hosts:        hosts,
 	}
 }
 
@@ -0,0 +1,125 @@
+package http
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket per client IP: each bucket holds up to burst tokens, refilled at rps tokens per
+// second, and every request takes one.
+type rateLimiter struct {
+	rps        float64
+	burst      float64
+	trustProxy bool
+
+	lk        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(rps float64, burst int, trustProxy bool) *rateLimiter {
+	if burst < 1 {
+		burst = int(math.Max(1, math.Ceil(rps)))
+	}
+	return &rateLimiter{
+		rps:        rps,
+		burst:      float64(burst),
+		trustProxy: trustProxy,
+		buckets:    make(map[string]*bucket),
+		lastSweep:  time.Now(),
+	}
+}
+
+// allow takes a token from the bucket of ip, it returns how long to wait for the next token if there is none
+func (l *rateLimiter) allow(ip string, now time.Time) (bool, time.Duration) {
+	l.lk.Lock()
+	defer l.lk.Unlock()
+
+	// a bucket idle for long enough to be full again is the same as no bucket, we drop them to bound the memory
+	refill := time.Duration(l.burst / l.rps * float64(time.Second))
+	if now.Sub(l.lastSweep) > refill {
+		for k, b := range l.buckets {
+			if now.Sub(b.last) > refill {
+				delete(l.buckets, k)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	b, ok := l.buckets[ip]
+	if !ok {
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[ip] = b
+	}
+	b.tokens = math.Min(l.burst, b.tokens+now.Sub(b.last).Seconds()*l.rps)
+	b.last = now
+	if b.tokens < 1 {
+		return false, time.Duration((1 - b.tokens) / l.rps * float64(time.Second))
+	}
+	b.tokens--
+	return true, 0
+}
+
+// clientIP is the IP the request comes from. Behind a trusted proxy, it is the last address of the X-Forwarded-For
+// header, the one the proxy appended: the addresses before it are set by the client and can't be trusted.
+func (l *rateLimiter) clientIP(r *http.Request) string {
+	if l.trustProxy {
+		if fwd := r.Header.Values("X-Forwarded-For"); len(fwd) > 0 {
+			addrs := strings.Split(fwd[len(fwd)-1], ",")
+			if ip := strings.TrimSpace(addrs[len(addrs)-1]); ip != "" {
+				return ip
+			}
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// SetRateLimit limits the requests of each client IP to rps per second on average, with bursts of up to burst
+// requests, a burst of 0 allowing rps requests at once. Requests over the limit get a 429 with a Retry-After header.
+// The health endpoints are never limited, so that probes keep working. If trustProxy is set, the client IP is taken
+// from the X-Forwarded-For header set by a proxy in front of the server, which has to overwrite or append to it.
+// A rate of 0 disables the limit, which is the default.
+func (h *DrandHandler) SetRateLimit(rps float64, burst int, trustProxy bool) {
+	h.state.Lock()
+	defer h.state.Unlock()
+
+	if rps <= 0 {
+		h.rateLimiter = nil
+		return
+	}
+	h.rateLimiter = newRateLimiter(rps, burst, trustProxy)
+}
+
+func (h *DrandHandler) withRateLimit(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.state.RLock()
+		l := h.rateLimiter
+		h.state.RUnlock()
+		if l == nil || path.Base(r.URL.Path) == "health" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if ok, wait := l.allow(l.clientIP(r), time.Now()); !ok {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+			http.Error(w, "too many requests", http.StatusTooManyRequests)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
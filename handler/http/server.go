@@ -1,6 +1,7 @@
 package http
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/hex"
@@ -8,6 +9,7 @@ import (
 	"math"
 	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
 	"sync"
 	"time"
@@ -22,6 +24,7 @@ import (
 	client2 "github.com/drand/drand/v2/common/client"
 	"github.com/drand/drand/v2/common/log"
 	"github.com/drand/drand/v2/common/tracer"
+	"github.com/drand/drand/v2/crypto"
 	"github.com/drand/drand/v2/internal/metrics"
 )
 
@@ -32,6 +35,20 @@ const (
 	roundNumSize        = 64
 	chainHashParamKey   = "chainHash"
 	roundParamKey       = "round"
+
+	// exportFlushEvery is the number of beacons written by the export endpoint between two flushes
+	exportFlushEvery = 100
+	// exportBufferSize is the size of the buffer beacons are written to before being sent to the client
+	exportBufferSize = 32 * 1024
+	// exportMaxRounds is the number of rounds a single export can span, larger ranges have to be exported in parts
+	exportMaxRounds = 1_000_000
+	// DefaultRangeMaxRounds is the default number of rounds a single request of the range endpoint can span
+	DefaultRangeMaxRounds = 1000
+
+	// ServerTimeHeader carries the server's wall-clock time, as unix seconds, when freshness headers are enabled.
+	ServerTimeHeader = "X-Drand-Server-Time"
+	// LatestRoundHeader carries the latest round known to the server when freshness headers are enabled.
+	LatestRoundHeader = "X-Drand-Latest-Round"
 )
 
 var (
@@ -52,6 +69,19 @@ type DrandHandler struct {
 	log     log.Logger
 	version string
 	state   sync.RWMutex
+
+	freshnessHeaders bool
+	rateLimiter      *rateLimiter
+	rangeMaxRounds   uint64
+}
+
+// RangeClient is implemented by the clients able to iterate over a range of rounds in a single call, such as
+// the ones reading from a local beacon store. The export endpoint falls back to getting rounds one by one for
+// the other clients.
+type RangeClient interface {
+	// Range calls fn on the randomness of each round from `from` to `to` included, in order, stopping at the
+	// first error.
+	Range(ctx context.Context, from, to uint64, fn func(client2.Result) error) error
 }
 
 type BeaconHandler struct {
@@ -82,6 +112,8 @@ func New(ctx context.Context, version string) (*DrandHandler, error) {
 		context: ctx,
 		version: version,
 		beacons: make(map[string]*BeaconHandler),
+
+		rangeMaxRounds: DefaultRangeMaxRounds,
 	}
 
 	instrument := func(h http.HandlerFunc, name string) http.HandlerFunc {
@@ -97,6 +129,10 @@ func New(ctx context.Context, version string) (*DrandHandler, error) {
 		"/{"+chainHashParamKey+"}/public/latest",
 		instrument(handler.LatestRand, chainHashParamKey+".LatestRand"),
 	)
+	mux.HandleFunc(
+		"/{"+chainHashParamKey+"}/public/range",
+		instrument(handler.PublicRange, chainHashParamKey+".PublicRange"),
+	)
 	mux.HandleFunc(
 		"/{"+chainHashParamKey+"}/public/{"+roundParamKey+"}",
 		instrument(handler.PublicRand, chainHashParamKey+".PublicRand"),
@@ -110,10 +146,19 @@ func New(ctx context.Context, version string) (*DrandHandler, error) {
 		instrument(handler.Health, chainHashParamKey+".Health"),
 	)
 
+	mux.HandleFunc(
+		"/{"+chainHashParamKey+"}/chain/export",
+		instrument(handler.Export, chainHashParamKey+".Export"),
+	)
+
 	mux.HandleFunc(
 		"/public/latest",
 		instrument(handler.LatestRand, "LatestRand"),
 	)
+	mux.HandleFunc(
+		"/public/range",
+		instrument(handler.PublicRange, "PublicRange"),
+	)
 	mux.HandleFunc(
 		"/public/{"+roundParamKey+"}",
 		instrument(handler.PublicRand, roundParamKey+".PublicRand"),
@@ -126,6 +171,10 @@ func New(ctx context.Context, version string) (*DrandHandler, error) {
 		"/health",
 		instrument(handler.Health, "Health"),
 	)
+	mux.HandleFunc(
+		"/chain/export",
+		instrument(handler.Export, "Export"),
+	)
 	mux.HandleFunc(
 		"/chains",
 		instrument(handler.ChainHashes, "ChainHashes"),
@@ -137,7 +186,7 @@ func New(ctx context.Context, version string) (*DrandHandler, error) {
 			metrics.HTTPLatency,
 			promhttp.InstrumentHandlerInFlight(
 				metrics.HTTPInFlight,
-				mux)))
+				handler.withRateLimit(mux))))
 
 	return handler, nil
 }
@@ -171,6 +220,49 @@ func (h *DrandHandler) SetHTTPHandler(newHandler http.Handler) {
 	h.httpHandler = newHandler
 }
 
+// SetFreshnessHeaders enables or disables the freshness headers on randomness responses.
+// They contain the server's current time and the latest round it has seen, allowing clients
+// to detect a stale or frozen server even when fetching historical rounds. The headers do not
+// alter the response body, so immutable round responses remain cacheable. Their values reflect
+// when the response was generated: a response served from a cache carries the time and latest
+// round of the server when it was cached, so clients checking liveness should compare them to
+// the Age header or bypass the cache.
+func (h *DrandHandler) SetFreshnessHeaders(enabled bool) {
+	h.state.Lock()
+	defer h.state.Unlock()
+
+	h.freshnessHeaders = enabled
+}
+
+// SetRangeMaxRounds sets how many rounds a single request of the range endpoint can span, DefaultRangeMaxRounds
+// by default. Larger ranges are rejected and have to be requested in parts.
+func (h *DrandHandler) SetRangeMaxRounds(n uint64) {
+	h.state.Lock()
+	defer h.state.Unlock()
+
+	h.rangeMaxRounds = n
+}
+
+func (h *DrandHandler) writeFreshnessHeaders(w http.ResponseWriter, bh *BeaconHandler, latest uint64) {
+	h.state.RLock()
+	enabled := h.freshnessHeaders
+	h.state.RUnlock()
+	if !enabled {
+		return
+	}
+
+	bh.pendingLk.RLock()
+	if bh.latestRound > latest {
+		latest = bh.latestRound
+	}
+	bh.pendingLk.RUnlock()
+
+	w.Header().Set(ServerTimeHeader, strconv.FormatInt(time.Now().Unix(), roundNumBase))
+	if latest > 0 {
+		w.Header().Set(LatestRoundHeader, strconv.FormatUint(latest, roundNumBase))
+	}
+}
+
 func (h *DrandHandler) RemoveBeaconHandler(chainHash string) {
 	h.state.Lock()
 	defer h.state.Unlock()
@@ -279,7 +371,11 @@ func (h *DrandHandler) getChainInfo(ctx context.Context, chainHash []byte) (*cha
 	if err != nil {
 		return nil, err
 	}
+	return h.beaconChainInfo(ctx, bh)
+}
 
+// beaconChainInfo returns the chain info of bh, fetching it from its client the first time
+func (h *DrandHandler) beaconChainInfo(ctx context.Context, bh *BeaconHandler) (*chain2.Info, error) {
 	bh.chainInfoLk.RLock()
 	if bh.chainInfo != nil {
 		// we want to return a copy in case it changes
@@ -403,7 +499,7 @@ func (h *DrandHandler) PublicRand(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, err = h.getBeaconHandler(chainHashHex)
+	bh, err := h.getBeaconHandler(chainHashHex)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusNotFound)
 		return
@@ -443,6 +539,7 @@ func (h *DrandHandler) PublicRand(w http.ResponseWriter, r *http.Request) {
 	// https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Cache-Control
 	// 604800 is one week of caching
 	w.Header().Set("Cache-Control", "public, max-age=604800, immutable")
+	h.writeFreshnessHeaders(w, bh, roundN)
 	http.ServeContent(w, r, "rand.json", roundExpectedTime, bytes.NewReader(data))
 }
 
@@ -510,6 +607,7 @@ func (h *DrandHandler) LatestRand(w http.ResponseWriter, r *http.Request) {
 
 	w.Header().Set("Expires", nextTime.Format(http.TimeFormat))
 	w.Header().Set("Last-Modified", roundTime.Format(http.TimeFormat))
+	h.writeFreshnessHeaders(w, bh, resp.GetRound())
 	_, _ = w.Write(data)
 }
 
@@ -591,19 +689,320 @@ func (h *DrandHandler) Health(w http.ResponseWriter, r *http.Request) {
 	_, _ = w.Write(b)
 }
 
-func (h *DrandHandler) ChainHashes(w http.ResponseWriter, _ *http.Request) {
-	chainHashes := make([]string, 0)
-	for chainHash := range h.beacons {
+// Export streams the beacons of the rounds between the `from` and `to` query parameters included, one JSON
+// object per line. `from` defaults to the first round and `to` to the latest one, and the range can't span more
+// than exportMaxRounds rounds.
+// Beacons are fetched and written out incrementally so that memory usage doesn't depend on the size of the
+// range: a client reading slowly slows down the export rather than having it buffered on the server.
+// Since the status is sent before the first beacon, the rounds missing from the chain are reported with a
+// {"missing_from":x,"missing_to":y} line in their place, and an export interrupted by an error ends with an
+// {"error":"..."} line.
+func (h *DrandHandler) Export(w http.ResponseWriter, r *http.Request) {
+	chainHashHex, err := readChainHash(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	bh, err := h.getBeaconHandler(chainHashHex)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	from, to, err := readRange(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	info, err := h.getChainInfo(r.Context(), chainHashHex)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		h.log.Warnw("", "http_server", "failed to get chain info", "client", r.RemoteAddr, "req", url.PathEscape(r.URL.Path), "err", err)
+		return
+	}
+	sch, err := crypto.GetSchemeByID(info.Scheme)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		h.log.Warnw("", "http_server", "unknown chain scheme", "client", r.RemoteAddr, "scheme", info.Scheme, "err", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	latest, err := bh.client.Get(ctx, 0)
+	cancel()
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		h.log.Warnw("", "http_server", "failed to get latest randomness", "client", r.RemoteAddr, "req", url.PathEscape(r.URL.Path), "err", err)
+		return
+	}
+	if to == 0 || to > latest.GetRound() {
+		to = latest.GetRound()
+	}
+	if from > to {
+		http.Error(w, fmt.Sprintf("invalid range: from %d is after to %d", from, to), http.StatusBadRequest)
+		return
+	}
+	if to-from >= exportMaxRounds {
+		http.Error(w, fmt.Sprintf("invalid range: at most %d rounds can be exported at once", exportMaxRounds),
+			http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.Header().Set("Cache-Control", "no-cache")
+	h.writeFreshnessHeaders(w, bh, latest.GetRound())
+	w.WriteHeader(http.StatusOK)
+
+	flusher, _ := w.(http.Flusher)
+	buf := bufio.NewWriterSize(w, exportBufferSize)
+	written := 0
+	writeLine := func(v any) error {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		data = append(data, '\n')
+		if _, err := buf.Write(data); err != nil {
+			return err
+		}
+		written++
+		if written%exportFlushEvery != 0 {
+			return nil
+		}
+		if err := buf.Flush(); err != nil {
+			return err
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return nil
+	}
+	// next is the next round expected, the rounds skipped until the one written are missing from the chain
+	next := from
+	write := func(res client2.Result) error {
+		if round := res.GetRound(); round > next {
+			if err := writeLine(&exportGap{MissingFrom: next, MissingTo: round - 1}); err != nil {
+				return err
+			}
+		}
+		next = res.GetRound() + 1
+		return writeLine(newExportedRound(res, sch))
+	}
+
+	if rc, ok := bh.client.(RangeClient); ok {
+		err = rc.Range(r.Context(), from, to, write)
+	} else {
+		err = h.exportRounds(r.Context(), bh, from, to, write)
+	}
+	if err == nil && next <= to {
+		err = writeLine(&exportGap{MissingFrom: next, MissingTo: to})
+	}
+	if err == nil {
+		err = buf.Flush()
+	}
+	if err != nil {
+		// the status has already been sent, so the error is reported in place of the rest of the export
+		h.log.Warnw("", "http_server", "export interrupted", "client", r.RemoteAddr, "from", from, "to", to, "written", written, "err", err)
+		if r.Context().Err() == nil && writeLine(&exportError{Error: err.Error()}) == nil {
+			_ = buf.Flush()
+		}
+	}
+}
+
+// PublicRange serves the beacons of the rounds between the `start` and `end` query parameters included, as a JSON
+// array in the format of the export. The range can't span more than the rounds set with SetRangeMaxRounds.
+// The rounds after the latest one aren't served yet, and neither are the ones missing from the chain, so only
+// a range whose rounds were all served is cached as immutable.
+func (h *DrandHandler) PublicRange(w http.ResponseWriter, r *http.Request) {
+	chainHashHex, err := readChainHash(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	bh, err := h.getBeaconHandler(chainHashHex)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	start, end, err := readStartEnd(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	h.state.RLock()
+	maxRounds := h.rangeMaxRounds
+	h.state.RUnlock()
+	if end < start {
+		http.Error(w, fmt.Sprintf("invalid range: start %d is after end %d", start, end), http.StatusBadRequest)
+		return
+	}
+	if end-start >= maxRounds {
+		http.Error(w, fmt.Sprintf("invalid range: at most %d rounds can be requested at once", maxRounds),
+			http.StatusBadRequest)
+		return
+	}
+
+	info, err := h.getChainInfo(r.Context(), chainHashHex)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		h.log.Warnw("", "http_server", "failed to get chain info", "client", r.RemoteAddr, "req", url.PathEscape(r.URL.Path), "err", err)
+		return
+	}
+	sch, err := crypto.GetSchemeByID(info.Scheme)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		h.log.Warnw("", "http_server", "unknown chain scheme", "client", r.RemoteAddr, "scheme", info.Scheme, "err", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	latest, err := bh.client.Get(ctx, 0)
+	cancel()
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		h.log.Warnw("", "http_server", "failed to get latest randomness", "client", r.RemoteAddr, "req", url.PathEscape(r.URL.Path), "err", err)
+		return
+	}
+	if start > latest.GetRound() {
+		timeToExpected := int(time.Until(dateOfRound(start, info)).Seconds())
+		w.Header().Set("Cache-Control", fmt.Sprintf("public, must-revalidate, max-age=%d", max(timeToExpected, 0)))
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	served := min(end, latest.GetRound())
+
+	rounds := make([]*exportedRound, 0, served-start+1)
+	collect := func(res client2.Result) error {
+		rounds = append(rounds, newExportedRound(res, sch))
+		return nil
+	}
+	if rc, ok := bh.client.(RangeClient); ok {
+		err = rc.Range(r.Context(), start, served, collect)
+	} else {
+		err = h.exportRounds(r.Context(), bh, start, served, collect)
+	}
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		h.log.Warnw("", "http_server", "failed to get range", "client", r.RemoteAddr, "start", start, "end", served, "err", err)
+		return
+	}
+	data, err := json.Marshal(rounds)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		h.log.Warnw("", "http_server", "failed to marshal range", "client", r.RemoteAddr, "err", err)
+		return
+	}
+
+	if served == end && uint64(len(rounds)) == end-start+1 {
+		w.Header().Set("Cache-Control", "public, max-age=604800, immutable")
+	} else {
+		// the range is served again once its next round is out
+		timeToNext := int(time.Until(dateOfRound(latest.GetRound()+1, info)).Seconds())
+		w.Header().Set("Cache-Control", fmt.Sprintf("public, must-revalidate, max-age=%d", max(timeToNext, 0)))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	h.writeFreshnessHeaders(w, bh, latest.GetRound())
+	http.ServeContent(w, r, "range.json", dateOfRound(served, info), bytes.NewReader(data))
+}
+
+// exportGap marks the rounds, from MissingFrom to MissingTo included, an export couldn't find in the chain
+type exportGap struct {
+	MissingFrom uint64 `json:"missing_from"`
+	MissingTo   uint64 `json:"missing_to"`
+}
+
+// exportError ends an export interrupted by an error
+type exportError struct {
+	Error string `json:"error"`
+}
+
+// exportedRound is a round as written by an export. Its randomness is derived from the signature with the digest
+// of the scheme of the chain, whatever the client it was fetched with.
+type exportedRound struct {
+	Round             uint64 `json:"round"`
+	Randomness        []byte `json:"randomness"`
+	Signature         []byte `json:"signature"`
+	PreviousSignature []byte `json:"previous_signature,omitempty"`
+}
+
+func newExportedRound(res client2.Result, sch *crypto.Scheme) *exportedRound {
+	e := &exportedRound{
+		Round:      res.GetRound(),
+		Randomness: sch.RandomnessFromSignature(res.GetSignature()),
+		Signature:  res.GetSignature(),
+	}
+	if p, ok := res.(interface{ GetPreviousSignature() []byte }); ok {
+		e.PreviousSignature = p.GetPreviousSignature()
+	}
+	return e
+}
+
+// exportRounds gets the rounds of an export one by one, for the clients that can't iterate over a range
+func (h *DrandHandler) exportRounds(ctx context.Context, bh *BeaconHandler, from, to uint64, fn func(client2.Result) error) error {
+	for round := from; round <= to; round++ {
+		rctx, cancel := context.WithTimeout(ctx, h.timeout)
+		res, err := bh.client.Get(rctx, round)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("unable to get round %d: %w", round, err)
+		}
+		if err := fn(res); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// servedChain is an entry of the chains listing: the hash of a chain served by the handler, and its info
+type servedChain struct {
+	Hash string          `json:"hash"`
+	Info json.RawMessage `json:"info"`
+}
+
+// ChainHashes lists the chains served, with their info in the same format as the info endpoint. The default
+// chain is only listed under its hash, and the chains whose info can't be fetched yet aren't listed, since
+// their other endpoints can't be served either.
+func (h *DrandHandler) ChainHashes(w http.ResponseWriter, r *http.Request) {
+	h.state.RLock()
+	beacons := make(map[string]*BeaconHandler, len(h.beacons))
+	for chainHash, bh := range h.beacons {
 		if chainHash != common.DefaultChainHash {
-			chainHashes = append(chainHashes, chainHash)
+			beacons[chainHash] = bh
+		}
+	}
+	h.state.RUnlock()
+
+	chains := make([]servedChain, 0, len(beacons))
+	for chainHash, bh := range beacons {
+		info, err := h.beaconChainInfo(r.Context(), bh)
+		if err != nil {
+			h.log.Debugw("not listing chain without chain info", "chainHash", chainHash, "err", err)
+			continue
+		}
+		var infoBuff bytes.Buffer
+		if err := info.ToJSON(&infoBuff, nil); err != nil {
+			h.log.Warnw("", "http_server", "failed to marshal chain info", "chainHash", chainHash, "err", err)
+			continue
 		}
+		chains = append(chains, servedChain{Hash: chainHash, Info: infoBuff.Bytes()})
+	}
+	sort.Slice(chains, func(i, j int) bool { return chains[i].Hash < chains[j].Hash })
+
+	b, err := json.Marshal(chains)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		h.log.Warnw("", "http_server", "failed to marshal chains", "client", r.RemoteAddr, "err", err)
+		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("Cache-Control", "max-age=300")
 
 	w.WriteHeader(http.StatusOK)
-	b, _ := json.Marshal(chainHashes)
 	_, _ = w.Write(b)
 }
 
@@ -627,6 +1026,39 @@ func readRound(r *http.Request) (uint64, error) {
 	return strconv.ParseUint(round, roundNumBase, roundNumSize)
 }
 
+// readRange reads the `from` and `to` query parameters of an export, 0 standing for a missing `to`
+func readRange(r *http.Request) (from, to uint64, err error) {
+	from = 1
+	if v := r.URL.Query().Get("from"); v != "" {
+		from, err = strconv.ParseUint(v, roundNumBase, roundNumSize)
+		if err != nil || from == 0 {
+			return 0, 0, fmt.Errorf("invalid from round %q", v)
+		}
+	}
+	if v := r.URL.Query().Get("to"); v != "" {
+		to, err = strconv.ParseUint(v, roundNumBase, roundNumSize)
+		if err != nil || to == 0 {
+			return 0, 0, fmt.Errorf("invalid to round %q", v)
+		}
+	}
+	return from, to, nil
+}
+
+// readStartEnd reads the mandatory `start` and `end` query parameters of a range
+func readStartEnd(r *http.Request) (start, end uint64, err error) {
+	for _, p := range []struct {
+		name  string
+		round *uint64
+	}{{"start", &start}, {"end", &end}} {
+		v := r.URL.Query().Get(p.name)
+		*p.round, err = strconv.ParseUint(v, roundNumBase, roundNumSize)
+		if err != nil || *p.round == 0 {
+			return 0, 0, fmt.Errorf("invalid %s round %q", p.name, v)
+		}
+	}
+	return start, end, nil
+}
+
 func dateOfRound(round uint64, info *chain2.Info) time.Time {
 	return time.Unix(common.TimeOfRound(info.Period, info.GenesisTime, round), 0)
 }
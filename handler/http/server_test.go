@@ -1,11 +1,17 @@
 package http_test
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strconv"
 	"testing"
 	"time"
 
@@ -13,6 +19,8 @@ import (
 	json "github.com/nikkolasg/hexjson"
 	"github.com/stretchr/testify/require"
 
+	"github.com/drand/drand/v2/common"
+	chain2 "github.com/drand/drand/v2/common/chain"
 	"github.com/drand/drand/v2/common/client"
 	"github.com/drand/drand/v2/common/log"
 	"github.com/drand/drand/v2/common/testlogger"
@@ -256,6 +264,55 @@ func TestHTTPHealth(t *testing.T) {
 	resp.Body.Close()
 }
 
+func TestHTTPFreshnessHeaders(t *testing.T) {
+	lg := testlogger.New(t)
+	ctx := log.ToContext(context.Background(), lg)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	clk := clock.NewFakeClockAt(time.Now())
+	c, _ := withClient(t, clk)
+
+	handler, err := dhttp.New(ctx, "")
+	require.NoError(t, err)
+
+	info, err := c.Info(ctx)
+	require.NoError(t, err)
+
+	handler.RegisterNewBeaconHandler(c, info.HashString())
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := http.Server{Handler: handler.GetHTTPHandler()}
+	go func() { _ = server.Serve(listener) }()
+	defer func() { _ = server.Shutdown(ctx) }()
+
+	time.Sleep(50 * time.Millisecond)
+
+	u := fmt.Sprintf("http://%s/%s/public/latest", listener.Addr().String(), info.HashString())
+	resp := getWithCtx(ctx, u, t)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Empty(t, resp.Header.Get(dhttp.ServerTimeHeader), "freshness headers should be disabled by default")
+	resp.Body.Close()
+
+	handler.SetFreshnessHeaders(true)
+
+	resp = getWithCtx(ctx, u, t)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NotEmpty(t, resp.Header.Get(dhttp.ServerTimeHeader))
+	require.NotEmpty(t, resp.Header.Get(dhttp.LatestRoundHeader))
+	resp.Body.Close()
+
+	u = fmt.Sprintf("http://%s/%s/public/1", listener.Addr().String(), info.HashString())
+	resp = getWithCtx(ctx, u, t)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Contains(t, resp.Header.Get("Cache-Control"), "immutable")
+	require.NotEmpty(t, resp.Header.Get(dhttp.ServerTimeHeader))
+	require.NotEmpty(t, resp.Header.Get(dhttp.LatestRoundHeader))
+	resp.Body.Close()
+}
+
 func TestHTTP404(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -303,3 +360,346 @@ func TestHTTP404(t *testing.T) {
 		t.Fatal("response should 404 on beacon hash that doesn't exist")
 	}
 }
+
+type syntheticResult struct {
+	Round      uint64 `json:"round"`
+	Randomness []byte `json:"randomness"`
+	Signature  []byte `json:"signature"`
+}
+
+func (r *syntheticResult) GetRound() uint64      { return r.Round }
+func (r *syntheticResult) GetRandomness() []byte { return r.Randomness }
+func (r *syntheticResult) GetSignature() []byte  { return r.Signature }
+
+// syntheticRangeClient generates the beacons of a chain of `latest` rounds on the fly. The missing rounds are
+// skipped by Range, as they are by a store, and Range fails on failAt.
+type syntheticRangeClient struct {
+	latest  uint64
+	missing map[uint64]bool
+	failAt  uint64
+}
+
+func (s *syntheticRangeClient) result(round uint64) client.Result {
+	sig := make([]byte, 96)
+	for i := range sig {
+		sig[i] = byte(round) + byte(i)
+	}
+	return &syntheticResult{Round: round, Randomness: sig[:32], Signature: sig}
+}
+
+func (s *syntheticRangeClient) Get(_ context.Context, round uint64) (client.Result, error) {
+	if round == 0 {
+		round = s.latest
+	}
+	return s.result(round), nil
+}
+
+func (s *syntheticRangeClient) Range(ctx context.Context, from, to uint64, fn func(client.Result) error) error {
+	for round := from; round <= to; round++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if round == s.failAt {
+			return fmt.Errorf("unable to read round %d", round)
+		}
+		if s.missing[round] {
+			continue
+		}
+		if err := fn(s.result(round)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *syntheticRangeClient) Watch(context.Context) <-chan client.Result { return nil }
+func (s *syntheticRangeClient) Info(context.Context) (*chain2.Info, error) {
+	return &chain2.Info{Scheme: crypto.DefaultSchemeID, Period: time.Second, GenesisTime: time.Now().Unix() - int64(s.latest)}, nil
+}
+func (s *syntheticRangeClient) RoundAt(time.Time) uint64 { return 0 }
+func (s *syntheticRangeClient) Close() error             { return nil }
+
+func heapInUse() uint64 {
+	runtime.GC()
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	return m.HeapInuse
+}
+
+func TestHTTPExportStreamsLargeRanges(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const rounds = 200_000
+	handler, err := dhttp.New(ctx, "")
+	require.NoError(t, err)
+	handler.RegisterNewBeaconHandler(&syntheticRangeClient{latest: rounds}, common.DefaultChainHash)
+
+	server := httptest.NewServer(handler.GetHTTPHandler())
+	defer server.Close()
+
+	resp := getWithCtx(ctx, server.URL+"/chain/export?from=0", t)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	resp.Body.Close()
+
+	resp = getWithCtx(ctx, server.URL+"/chain/export?from=10&to=5", t)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	resp.Body.Close()
+
+	resp = getWithCtx(ctx, server.URL+"/chain/export?from=5&to=9", t)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "application/x-ndjson", resp.Header.Get("Content-Type"))
+	scanner := bufio.NewScanner(resp.Body)
+	expected := uint64(5)
+	for scanner.Scan() {
+		var res syntheticResult
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &res))
+		require.Equal(t, expected, res.Round)
+		// the randomness is derived from the signature, not taken from the client
+		require.Equal(t, crypto.RandomnessFromSignature(res.Signature), res.Randomness)
+		expected++
+	}
+	resp.Body.Close()
+	require.Equal(t, uint64(10), expected)
+
+	// the whole chain is far larger than the memory the export is allowed to use
+	before := heapInUse()
+	resp = getWithCtx(ctx, server.URL+"/chain/export", t)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	defer resp.Body.Close()
+
+	var maxGrowth, size uint64
+	reader := bufio.NewReader(resp.Body)
+	count := uint64(0)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		count++
+		size += uint64(len(line))
+		if count%(rounds/10) == 0 {
+			// reading slowly must make the server wait rather than buffer the export
+			time.Sleep(20 * time.Millisecond)
+			if now := heapInUse(); now > before && now-before > maxGrowth {
+				maxGrowth = now - before
+			}
+		}
+	}
+	require.Equal(t, uint64(rounds), count)
+	require.Greater(t, size, uint64(32<<20))
+	require.Less(t, maxGrowth, uint64(8<<20), "export memory grew by %d bytes", maxGrowth)
+}
+
+func TestHTTPExportReportsGapsAndErrors(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	handler, err := dhttp.New(ctx, "")
+	require.NoError(t, err)
+	server := httptest.NewServer(handler.GetHTTPHandler())
+	defer server.Close()
+
+	export := func(c *syntheticRangeClient, query string) []map[string]any {
+		handler.RegisterNewBeaconHandler(c, common.DefaultChainHash)
+		resp := getWithCtx(ctx, server.URL+"/chain/export"+query, t)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var lines []map[string]any
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			line := make(map[string]any)
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+			lines = append(lines, line)
+		}
+		return lines
+	}
+
+	// the missing rounds are reported in their place, including at the end of the range
+	lines := export(&syntheticRangeClient{latest: 20, missing: map[uint64]bool{7: true, 8: true, 10: true}}, "?from=5&to=10")
+	require.Len(t, lines, 5)
+	require.Equal(t, float64(5), lines[0]["round"])
+	require.Equal(t, float64(6), lines[1]["round"])
+	require.Equal(t, map[string]any{"missing_from": float64(7), "missing_to": float64(8)}, lines[2])
+	require.Equal(t, float64(9), lines[3]["round"])
+	require.Equal(t, map[string]any{"missing_from": float64(10), "missing_to": float64(10)}, lines[4])
+
+	// an export interrupted by an error ends with it
+	lines = export(&syntheticRangeClient{latest: 20, failAt: 7}, "?from=5&to=10")
+	require.Len(t, lines, 3)
+	require.Equal(t, float64(6), lines[1]["round"])
+	require.Equal(t, map[string]any{"error": "unable to read round 7"}, lines[2])
+
+	// the range an export spans is bounded
+	handler.RegisterNewBeaconHandler(&syntheticRangeClient{latest: 2_000_000}, common.DefaultChainHash)
+	resp := getWithCtx(ctx, server.URL+"/chain/export", t)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	resp.Body.Close()
+}
+
+// getOnlyClient hides the Range method of its client, for the rounds to be fetched one by one
+type getOnlyClient struct {
+	client.Client
+}
+
+func TestHTTPPublicRange(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	handler, err := dhttp.New(ctx, "")
+	require.NoError(t, err)
+	server := httptest.NewServer(handler.GetHTTPHandler())
+	defer server.Close()
+
+	get := func(path string) *http.Response {
+		resp := getWithCtx(ctx, server.URL+path, t)
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+	rounds := func(resp *http.Response) []uint64 {
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+		var served []map[string]any
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&served))
+		list := make([]uint64, 0, len(served))
+		for _, r := range served {
+			require.NotEmpty(t, r["randomness"])
+			list = append(list, uint64(r["round"].(float64)))
+		}
+		return list
+	}
+
+	c := &syntheticRangeClient{latest: 20, missing: map[uint64]bool{12: true}}
+	handler.RegisterNewBeaconHandler(c, common.DefaultChainHash)
+	handler.RegisterNewBeaconHandler(getOnlyClient{c}, "deadbeef")
+
+	// a historical range is immutable, with the chain hash prefix or without it
+	for _, path := range []string{"/public/range?start=3&end=6", "/deadbeef/public/range?start=3&end=6"} {
+		resp := get(path)
+		require.Equal(t, []uint64{3, 4, 5, 6}, rounds(resp), path)
+		require.Contains(t, resp.Header.Get("Cache-Control"), "immutable", path)
+	}
+	resp := get("/public/range?start=7&end=7")
+	require.Equal(t, []uint64{7}, rounds(resp))
+	require.Contains(t, resp.Header.Get("Cache-Control"), "immutable")
+
+	// a range with missing rounds, or going past the latest round, isn't complete yet
+	resp = get("/public/range?start=10&end=13")
+	require.Equal(t, []uint64{10, 11, 13}, rounds(resp))
+	require.NotContains(t, resp.Header.Get("Cache-Control"), "immutable")
+	resp = get("/public/range?start=19&end=25")
+	require.Equal(t, []uint64{19, 20}, rounds(resp))
+	require.NotContains(t, resp.Header.Get("Cache-Control"), "immutable")
+	require.Equal(t, http.StatusNotFound, get("/public/range?start=21&end=25").StatusCode)
+
+	// inverted, oversized and malformed ranges are rejected
+	for _, query := range []string{"start=6&end=5", "start=1&end=1001", "start=0&end=5", "start=5", "end=5", "start=a&end=5"} {
+		require.Equal(t, http.StatusBadRequest, get("/public/range?"+query).StatusCode, query)
+	}
+	require.Equal(t, http.StatusOK, get("/public/range?start=1&end=1000").StatusCode)
+	handler.SetRangeMaxRounds(5)
+	require.Equal(t, http.StatusBadRequest, get("/public/range?start=1&end=6").StatusCode)
+	require.Equal(t, http.StatusOK, get("/public/range?start=1&end=5").StatusCode)
+}
+
+func TestHTTPRateLimit(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	handler, err := dhttp.New(ctx, "")
+	require.NoError(t, err)
+	get := func(url, remote string, forwarded ...string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, url, http.NoBody)
+		req.RemoteAddr = remote
+		for _, fwd := range forwarded {
+			req.Header.Add("X-Forwarded-For", fwd)
+		}
+		rec := httptest.NewRecorder()
+		handler.GetHTTPHandler().ServeHTTP(rec, req)
+		return rec
+	}
+
+	// no limit by default
+	for i := 0; i < 10; i++ {
+		require.Equal(t, http.StatusOK, get("/chains", "10.0.0.1:1234").Code)
+	}
+
+	// a rate low enough for no token to be refilled during the test
+	handler.SetRateLimit(0.01, 2, false)
+	require.Equal(t, http.StatusOK, get("/chains", "10.0.0.1:1234").Code)
+	require.Equal(t, http.StatusOK, get("/chains", "10.0.0.1:5678").Code)
+	rec := get("/chains", "10.0.0.1:1234")
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	retryAfter, err := strconv.Atoi(rec.Header().Get("Retry-After"))
+	require.NoError(t, err)
+	require.InDelta(t, 100, retryAfter, 1)
+
+	// the health endpoints aren't limited, and the other IPs have their own bucket
+	require.NotEqual(t, http.StatusTooManyRequests, get("/health", "10.0.0.1:1234").Code)
+	require.NotEqual(t, http.StatusTooManyRequests, get("/"+common.DefaultChainHash+"/health", "10.0.0.1:1234").Code)
+	require.Equal(t, http.StatusOK, get("/chains", "10.0.0.2:1234").Code)
+
+	// X-Forwarded-For is ignored unless the proxy is trusted
+	require.Equal(t, http.StatusTooManyRequests, get("/chains", "10.0.0.1:1234", "192.168.0.1").Code)
+
+	// behind a trusted proxy, the last forwarded address is the client's
+	handler.SetRateLimit(0.01, 1, true)
+	require.Equal(t, http.StatusOK, get("/chains", "10.0.0.1:1234", "192.168.0.1").Code)
+	require.Equal(t, http.StatusTooManyRequests, get("/chains", "10.0.0.1:1234", "192.168.0.1").Code)
+	require.Equal(t, http.StatusTooManyRequests, get("/chains", "10.0.0.1:1234", "6.6.6.6, 192.168.0.1").Code)
+	require.Equal(t, http.StatusOK, get("/chains", "10.0.0.1:1234", "192.168.0.1", "192.168.0.2").Code)
+
+	handler.SetRateLimit(0, 0, false)
+	require.Equal(t, http.StatusOK, get("/chains", "10.0.0.1:1234", "192.168.0.1").Code)
+}
+
+// noInfoClient is a client whose chain info can't be fetched, as when its chain isn't reachable yet
+type noInfoClient struct {
+	client.Client
+}
+
+func (noInfoClient) Info(context.Context) (*chain2.Info, error) {
+	return nil, errors.New("chain info not available yet")
+}
+
+func TestHTTPChains(t *testing.T) {
+	lg := testlogger.New(t)
+	ctx := log.ToContext(context.Background(), lg)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	c, _ := withClient(t, clock.NewFakeClockAt(time.Now()))
+	info, err := c.Info(ctx)
+	require.NoError(t, err)
+
+	handler, err := dhttp.New(ctx, "")
+	require.NoError(t, err)
+	server := httptest.NewServer(handler.GetHTTPHandler())
+	defer server.Close()
+
+	listChains := func() []map[string]json.RawMessage {
+		resp := getWithCtx(ctx, server.URL+"/chains", t)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+		var chains []map[string]json.RawMessage
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&chains))
+		return chains
+	}
+	require.Empty(t, listChains())
+
+	// the default chain is listed once, under its hash, and the chain without info isn't listed
+	bh := handler.RegisterNewBeaconHandler(c, info.HashString())
+	handler.RegisterDefaultBeaconHandler(bh)
+	handler.RegisterNewBeaconHandler(noInfoClient{c}, "deadbeef")
+
+	chains := listChains()
+	require.Len(t, chains, 1)
+	var hash string
+	require.NoError(t, json.Unmarshal(chains[0]["hash"], &hash))
+	require.Equal(t, info.HashString(), hash)
+	listed, err := chain2.InfoFromJSON(bytes.NewReader(chains[0]["info"]))
+	require.NoError(t, err)
+	require.True(t, listed.Equal(info))
+}
@@ -57,8 +57,10 @@ func (c *partialCache) Append(p *drand.PartialBeaconPacket) error {
 	return nil
 }
 
-// FlushRounds deletes all rounds cache that are inferior or equal to `round`.
-func (c *partialCache) FlushRounds(round uint64) {
+// FlushRounds deletes all rounds cache that are inferior or equal to `round`
+// and returns them.
+func (c *partialCache) FlushRounds(round uint64) []*roundCache {
+	var flushed []*roundCache
 	for id, cache := range c.rounds {
 		if cache.round > round {
 			continue
@@ -66,6 +68,7 @@ func (c *partialCache) FlushRounds(round uint64) {
 
 		// delete the cache entry
 		delete(c.rounds, id)
+		flushed = append(flushed, cache)
 		// delete the counter of each nodes that participated in that round
 		for idx := range cache.sigs {
 			var idSlice = c.rcvd[idx][:0]
@@ -82,6 +85,7 @@ func (c *partialCache) FlushRounds(round uint64) {
 			}
 		}
 	}
+	return flushed
 }
 
 func (c *partialCache) GetRoundCache(round uint64, previous []byte) *roundCache {
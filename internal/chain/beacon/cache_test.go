@@ -107,7 +107,12 @@ func TestCachePartial(t *testing.T) {
 	require.Equal(t, total, len(cache.rounds))
 
 	// flush all new rounds just inserted
-	cache.FlushRounds(round - 1)
+	flushed := cache.FlushRounds(round - 1)
+	require.Len(t, flushed, toFlush)
+	for _, r := range flushed {
+		require.Less(t, r.round, round)
+		require.Equal(t, 1, r.Len())
+	}
 	require.Equal(t, total-toFlush, len(cache.rounds))
 	for i := 1; i <= toFlush; i++ {
 		require.Nil(t, cache.rcvd[i+1], "failed for signer %d", i+1)
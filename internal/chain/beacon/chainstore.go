@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"strings"
+	"time"
 
 	"github.com/drand/drand/v2/common/tracer"
 
@@ -16,6 +18,7 @@ import (
 	"github.com/drand/drand/v2/common/log"
 	"github.com/drand/drand/v2/crypto/vault"
 	"github.com/drand/drand/v2/internal/chain"
+	"github.com/drand/drand/v2/internal/metrics"
 	"github.com/drand/drand/v2/internal/net"
 	"github.com/drand/drand/v2/protobuf/drand"
 )
@@ -81,6 +84,7 @@ func newChainStore(ctx context.Context, l log.Logger, cf *Config, cl net.Protoco
 		Client:      cl,
 		Clock:       cf.Clock,
 		NodeAddr:    cf.Public.Address(),
+		Sources:     cf.SyncSources,
 	})
 	if err != nil {
 		span.RecordError(err)
@@ -140,6 +144,13 @@ func (c *chainStore) Stop() {
 // especially in case of a quick catchup.
 const partialCacheStoreLimit = uint64(3)
 
+// reasons for which the aggregation of a round fails, as reported by metrics.BeaconRoundFailures
+const (
+	roundFailureNotEnoughPartials = "not_enough_partials"
+	roundFailureInvalidRecovery   = "invalid_recovery"
+	roundFailureInvalidSignature  = "invalid_signature"
+)
+
 // runAggregator runs a continuous loop that tries to aggregate partial
 // signatures when it can.
 //
@@ -154,12 +165,15 @@ func (c *chainStore) runAggregator() {
 	var lastBeacon *common.Beacon
 
 	var cache = newPartialCache(c.l, c.crypto.Scheme)
+	var traces = newRoundTraces(c.ctx)
+	defer traces.flush(math.MaxUint64)
 	for {
 		select {
 		case <-c.ctx.Done():
 			return
 		case lastBeacon = <-c.beaconStoredAgg:
-			cache.FlushRounds(lastBeacon.Round)
+			c.flushRounds(cache, lastBeacon.Round)
+			traces.flush(lastBeacon.Round)
 		case partial := <-c.newPartials:
 			ctx, span := tracer.NewSpanFromSpanContext(c.ctx, partial.spanContext, "c.runAggregator")
 
@@ -230,6 +244,8 @@ func (c *chainStore) runAggregator() {
 				span.End()
 				break
 			}
+			round := traces.get(pRound, common.GetCanonicalBeaconID(c.crypto.GetGroup().ID))
+			round.collect.AddEvent("partial", oteltrace.WithAttributes(attribute.String("addr", partial.addr)))
 
 			c.l.Debugw("", "store_partial", partial.addr,
 				"round", roundCache.round, "len_partials", fmt.Sprintf("%d/%d", roundCache.Len(), thr))
@@ -239,23 +255,35 @@ func (c *chainStore) runAggregator() {
 				break
 			}
 
+			round.collected()
+			beaconID := common.GetCanonicalBeaconID(c.crypto.GetGroup().ID)
+			metrics.BeaconPartialsReceived.WithLabelValues(beaconID).Observe(float64(roundCache.Len()))
 			msg := c.crypto.DigestBeacon(roundCache)
 
+			_, recoverSpan := round.step("beacon.round.recoverSignature")
 			finalSig, err := c.crypto.Scheme.ThresholdScheme.Recover(c.crypto.GetPub(), msg, roundCache.Partials(), thr, n)
+			recoverSpan.End()
 			if err != nil {
 				c.l.Errorw("invalid_recovery", "error", err, "round", pRound, "got", fmt.Sprintf("%d/%d", roundCache.Len(), n))
 				span.RecordError(errors.New("invalid recovery"))
+				metrics.BeaconRoundFailures.WithLabelValues(beaconID, roundFailureInvalidRecovery).Inc()
+				traces.end(pRound, err)
 				break
 			}
-			if err := c.crypto.Scheme.ThresholdScheme.VerifyRecovered(c.crypto.GetPub().Commit(), msg, finalSig); err != nil {
+			_, verifySpan := round.step("beacon.round.verifySignature")
+			err = c.crypto.Scheme.ThresholdScheme.VerifyRecovered(c.crypto.GetPub().Commit(), msg, finalSig)
+			verifySpan.End()
+			if err != nil {
 				c.l.Errorw("invalid_sig", "error", err, "round", pRound)
 				span.RecordError(errors.New("invalid signature"))
+				metrics.BeaconRoundFailures.WithLabelValues(beaconID, roundFailureInvalidSignature).Inc()
+				traces.end(pRound, err)
 				span.End()
 				break
 			}
 
 			span.AddEvent("cache.FlushRounds")
-			cache.FlushRounds(partial.p.GetRound())
+			c.flushRounds(cache, partial.p.GetRound())
 			span.AddEvent("cache.FlushRounds - done")
 
 			newBeacon := &common.Beacon{
@@ -265,12 +293,16 @@ func (c *chainStore) runAggregator() {
 			}
 
 			c.l.Infow("", "aggregated_beacon", newBeacon.Round)
+			aggregatedAt := c.conf.Clock.Now()
 			span.AddEvent("calling tryAppend")
-			if c.tryAppend(ctx, lastBeacon, newBeacon) {
+			if c.tryAppend(round.ctx, lastBeacon, newBeacon) {
+				c.observeStoreLag(newBeacon.Round, c.conf.Clock.Now().Sub(aggregatedAt))
 				lastBeacon = newBeacon
+				traces.end(pRound, nil)
 				span.End()
 				break
 			}
+			round.span.AddEvent("not appendable", oteltrace.WithAttributes(attribute.Int64("last", int64(lastBeacon.Round))))
 
 			select {
 			case <-c.ctx.Done():
@@ -291,6 +323,33 @@ func (c *chainStore) runAggregator() {
 	}
 }
 
+// flushRounds flushes the partials of the rounds up to the given one from the cache. The rounds that never reached
+// the threshold have failed to be aggregated by this node, their beacon was either stored by another path, e.g. the
+// sync manager, or won't be anymore.
+func (c *chainStore) flushRounds(cache *partialCache, upTo uint64) {
+	group := c.crypto.GetGroup()
+	for _, round := range cache.FlushRounds(upTo) {
+		if round.Len() >= group.Threshold {
+			continue
+		}
+		c.l.Debugw("round not aggregated", "round", round.round, "len_partials", fmt.Sprintf("%d/%d", round.Len(), group.Threshold))
+		metrics.BeaconRoundFailures.WithLabelValues(common.GetCanonicalBeaconID(group.ID), roundFailureNotEnoughPartials).Inc()
+	}
+}
+
+// observeStoreLag records the time it took for an aggregated beacon to be committed to the store. A growing lag
+// is an early sign of write pressure on the store, so we warn when it reaches half a period.
+func (c *chainStore) observeStoreLag(round uint64, lag time.Duration) {
+	group := c.crypto.GetGroup()
+	metrics.BeaconStoreLag.WithLabelValues(common.GetCanonicalBeaconID(group.ID)).Observe(lag.Seconds())
+	//nolint:mnd // half a period
+	if lag > group.Period/2 {
+		c.l.Warnw("high store write lag", "round", round, "store_lag_ms", lag.Milliseconds(), "period", group.Period)
+		return
+	}
+	c.l.Debugw("", "stored_beacon", round, "store_lag_ms", lag.Milliseconds())
+}
+
 func (c *chainStore) tryAppend(ctx context.Context, last, newB *common.Beacon) bool {
 	ctx, span := tracer.NewSpan(ctx, "chainStore.tryAppend")
 	defer span.End()
@@ -306,7 +365,10 @@ func (c *chainStore) tryAppend(ctx context.Context, last, newB *common.Beacon) b
 		return false
 	}
 
-	if err := c.CallbackStore.Put(ctx, newB); err != nil {
+	putCtx, putSpan := tracer.NewSpan(ctx, "chainStore.Put")
+	err := c.CallbackStore.Put(putCtx, newB)
+	putSpan.End()
+	if err != nil {
 		span.RecordError(err)
 		// if round is ok but bytes are different, error will be raised
 		if errors.Is(err, ErrBeaconAlreadyStored) {
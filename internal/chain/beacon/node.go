@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"runtime"
 	"sync"
 	"time"
 
@@ -33,6 +34,15 @@ type Config struct {
 	Group *key.Group
 	// Clock to use - useful to testing
 	Clock clock.Clock
+	// SyncSources are the peers to sync from first, in order, before the nodes of the group
+	SyncSources []net.Peer
+	// StallPeriods is the number of periods the beacon loop can go without handling a round before being reported
+	// as stalled, 0 disables the watchdog
+	StallPeriods int
+	// OnStall is called when the beacon loop is reported as stalled, e.g. to exit for a supervised restart
+	OnStall func()
+	// PartialVerifiers is the maximum number of partial signatures verified at once, GOMAXPROCS if 0
+	PartialVerifiers int
 }
 
 // Handler holds the logic to initiate, and react to the tBLS protocol. Each time
@@ -50,6 +60,9 @@ type Handler struct {
 	chain            *chainStore
 	ticker           *ticker
 	thresholdMonitor *metrics.ThresholdMonitor
+	// watchdog reports the run loop as stalled, nil if disabled
+	watchdog *watchdog
+	verifier *partialVerifier
 
 	ctx       context.Context
 	ctxCancel context.CancelFunc
@@ -115,6 +128,15 @@ func NewHandler(ctx context.Context, c net.ProtocolClient, s chain.Store, conf *
 		version:          version,
 		thresholdMonitor: metrics.NewThresholdMonitor(conf.Group.ID, l, conf.Group.Len(), conf.Group.Threshold),
 	}
+	verifiers := conf.PartialVerifiers
+	if verifiers <= 0 {
+		verifiers = runtime.GOMAXPROCS(0)
+	}
+	handler.verifier = newPartialVerifier(common.GetCanonicalBeaconID(conf.Group.ID), verifiers)
+	if conf.StallPeriods > 0 {
+		handler.watchdog = newWatchdog(l, conf.Clock, common.GetCanonicalBeaconID(conf.Group.ID),
+			time.Duration(conf.StallPeriods)*conf.Group.Period, conf.OnStall)
+	}
 	return handler, nil
 }
 
@@ -179,7 +201,9 @@ func (h *Handler) ProcessPartialBeacon(ctx context.Context, p *proto.PartialBeac
 
 	// verify if request is valid
 	span.AddEvent("h.crypto.ThresholdScheme.VerifyPartial")
-	err = h.crypto.ThresholdScheme.VerifyPartial(h.crypto.GetPub(), msg, p.GetPartialSig())
+	err = h.verifier.verify(ctx, func() error {
+		return h.crypto.ThresholdScheme.VerifyPartial(h.crypto.GetPub(), msg, p.GetPartialSig())
+	})
 	span.AddEvent("h.crypto.ThresholdScheme.VerifyPartial - done")
 
 	if err != nil {
@@ -358,6 +382,9 @@ func (h *Handler) run(startTime int64) {
 
 	chanTick := h.ticker.ChannelAt(startTime)
 	h.l.Infow("starting handler run", "startTime", startTime, "current time", h.conf.Clock.Now().Unix())
+	if h.watchdog != nil {
+		go h.watchdog.run(h.ctx)
+	}
 
 	var current roundInfo
 	setServing := sync.Once{}
@@ -404,6 +431,9 @@ func (h *Handler) run(startTime int64) {
 					h.chain.RunSync(ctx, current.round, nil)
 				}
 			}()
+			if h.watchdog != nil {
+				h.watchdog.beat()
+			}
 		case b := <-h.chain.AppendedBeaconNoSync():
 			ctx, span := tracer.NewSpan(h.ctx, "h.run.appendBeaconNoSync")
 			span.SetAttributes(
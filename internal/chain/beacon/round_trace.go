@@ -0,0 +1,83 @@
+package beacon
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	oteltrace "go.opentelemetry.io/otel/trace"
+
+	"github.com/drand/drand/v2/common/tracer"
+)
+
+// roundTrace holds the spans tracing the aggregation of one round: a span covering the whole round, from its first
+// partial until its beacon is stored, and a child span for the collection of its partials.
+type roundTrace struct {
+	ctx     context.Context
+	span    oteltrace.Span
+	collect oteltrace.Span
+}
+
+// step starts a child span of the round, e.g. for the recovery or the verification of its signature
+func (r *roundTrace) step(name string) (context.Context, oteltrace.Span) {
+	return tracer.NewSpan(r.ctx, name)
+}
+
+// collected ends the collection of the partials, once the threshold is reached
+func (r *roundTrace) collected() {
+	r.collect.End()
+}
+
+// roundTraces keeps the traces of the rounds being aggregated. It is only used from the aggregation loop, so it
+// needs no locking.
+type roundTraces struct {
+	ctx    context.Context
+	rounds map[uint64]*roundTrace
+}
+
+func newRoundTraces(ctx context.Context) *roundTraces {
+	return &roundTraces{ctx: ctx, rounds: make(map[uint64]*roundTrace)}
+}
+
+// get returns the trace of the given round, starting it if this is its first partial
+func (t *roundTraces) get(round uint64, beaconID string) *roundTrace {
+	if r, ok := t.rounds[round]; ok {
+		return r
+	}
+	attrs := oteltrace.WithAttributes(
+		attribute.Int64("round", int64(round)),
+		attribute.String("beaconID", beaconID),
+	)
+	ctx, span := tracer.NewSpan(t.ctx, "beacon.round", attrs)
+	_, collect := tracer.NewSpan(ctx, "beacon.round.collectPartials", attrs)
+	r := &roundTrace{ctx: ctx, span: span, collect: collect}
+	t.rounds[round] = r
+	return r
+}
+
+// end ends the trace of the given round, recording the error that stopped its aggregation if any
+func (t *roundTraces) end(round uint64, err error) {
+	r, ok := t.rounds[round]
+	if !ok {
+		return
+	}
+	if err != nil {
+		r.span.RecordError(err)
+		r.span.SetStatus(codes.Error, err.Error())
+	}
+	r.collect.End()
+	r.span.End()
+	delete(t.rounds, round)
+}
+
+// flush ends the traces of the rounds up to the given one, whose beacons have been stored by another path,
+// e.g. the sync manager, or won't be aggregated anymore
+func (t *roundTraces) flush(upTo uint64) {
+	for round, r := range t.rounds {
+		if round > upTo {
+			continue
+		}
+		r.span.AddEvent("stored without aggregation")
+		t.end(round, nil)
+	}
+}
@@ -0,0 +1,49 @@
+package beacon
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestRoundTraces(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	traces := newRoundTraces(context.Background())
+	round := traces.get(2, "default")
+	require.Same(t, round, traces.get(2, "default"))
+	round.collected()
+	_, step := round.step("beacon.round.recoverSignature")
+	step.End()
+	traces.end(2, nil)
+
+	traces.get(3, "default")
+	traces.get(4, "default")
+	traces.end(3, errors.New("invalid signature"))
+	traces.flush(4)
+	require.Empty(t, traces.rounds)
+
+	spans := make(map[string][]sdktrace.ReadOnlySpan)
+	for _, s := range recorder.Ended() {
+		spans[s.Name()] = append(spans[s.Name()], s)
+	}
+	require.Len(t, spans["beacon.round"], 3)
+	require.Len(t, spans["beacon.round.collectPartials"], 3)
+	require.Len(t, spans["beacon.round.recoverSignature"], 1)
+
+	// the steps of a round are children of its span
+	first := spans["beacon.round"][0]
+	require.Equal(t, first.SpanContext().SpanID(), spans["beacon.round.recoverSignature"][0].Parent().SpanID())
+	require.Equal(t, first.SpanContext().SpanID(), spans["beacon.round.collectPartials"][0].Parent().SpanID())
+	require.Equal(t, codes.Error, spans["beacon.round"][1].Status().Code)
+	require.Equal(t, "stored without aggregation", spans["beacon.round"][2].Events()[0].Name)
+}
@@ -177,14 +177,19 @@ func (d *discrepancyStore) Put(ctx context.Context, b *common.Beacon) error {
 	beaconID := common.GetCanonicalBeaconID(d.group.ID)
 	metrics.BeaconDiscrepancyLatency.WithLabelValues(beaconID).Set(discrepancy)
 	metrics.LastBeaconRound.WithLabelValues(beaconID).Set(float64(b.GetRound()))
+	metrics.LastRoundStored(beaconID, time.Unix(0, expected))
 	metrics.GroupSize.WithLabelValues(beaconID).Set(float64(d.group.Len()))
 	metrics.GroupThreshold.WithLabelValues(beaconID).Set(float64(d.group.Threshold))
 	// in order to avoid spamming the logs, e.g. during syncing
 	if !dcontext.IsSkipLogsFromContext(ctx) {
+		// the rounds synced from other nodes would skew the delays, since they can be stored long after their time
+		delay := storageTime.Sub(time.Unix(0, expected))
+		metrics.BeaconDeliveryDelay.WithLabelValues(beaconID).Observe(delay.Seconds())
 		d.l.Infow("",
 			"NEW_BEACON_STORED", b.String(),
 			"time_discrepancy_ms", discrepancy,
 			"storage_time_ms", storageTime.Sub(actual).Milliseconds(),
+			"delivery_delay_ms", delay.Milliseconds(),
 		)
 	}
 	return nil
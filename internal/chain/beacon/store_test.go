@@ -4,14 +4,21 @@ import (
 	"bytes"
 	"context"
 	"testing"
+	"time"
 
+	clock "github.com/jonboulle/clockwork"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
 
 	"github.com/drand/drand/v2/common"
+	"github.com/drand/drand/v2/common/key"
 	"github.com/drand/drand/v2/common/testlogger"
 	"github.com/drand/drand/v2/crypto"
 	"github.com/drand/drand/v2/internal/chain"
 	"github.com/drand/drand/v2/internal/chain/boltdb"
+	"github.com/drand/drand/v2/internal/chain/memdb"
+	dcontext "github.com/drand/drand/v2/internal/context"
+	"github.com/drand/drand/v2/internal/metrics"
 	context2 "github.com/drand/drand/v2/internal/test/context"
 )
 
@@ -71,3 +78,36 @@ func TestSchemeStore(t *testing.T) {
 		}
 	}
 }
+
+func TestDiscrepancyStoreDeliveryDelay(t *testing.T) {
+	ctx := context.Background()
+	group := &key.Group{ID: "delivery_delay", Period: 3 * time.Second, GenesisTime: 1_000_000}
+	roundTime := time.Unix(common.TimeOfRound(group.Period, group.GenesisTime, 5), 0)
+	clk := clock.NewFakeClockAt(roundTime.Add(1500 * time.Millisecond))
+	store := newDiscrepancyStore(memdb.NewStore(10), testlogger.New(t), group, clk)
+
+	registry := prometheus.NewRegistry()
+	require.NoError(t, registry.Register(metrics.BeaconDeliveryDelay))
+	delays := func() (count uint64, sum float64) {
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		for _, family := range families {
+			for _, m := range family.GetMetric() {
+				if m.GetLabel()[0].GetValue() == group.ID {
+					return m.GetHistogram().GetSampleCount(), m.GetHistogram().GetSampleSum()
+				}
+			}
+		}
+		return 0, 0
+	}
+
+	require.NoError(t, store.Put(ctx, &common.Beacon{Round: 5, Signature: []byte("signature_5")}))
+	count, sum := delays()
+	require.Equal(t, uint64(1), count)
+	require.InDelta(t, 1.5, sum, 1e-9)
+
+	// the rounds synced from other nodes aren't observed
+	require.NoError(t, store.Put(dcontext.SetSkipLogs(ctx, true), &common.Beacon{Round: 6, Signature: []byte("signature_6")}))
+	count, _ = delays()
+	require.Equal(t, uint64(1), count)
+}
@@ -49,6 +49,8 @@ type SyncManager struct {
 	newSyncedBeacon chan *commonutils.Beacon
 	// we need to know our current daemon address
 	nodeAddr string
+	// peers tried first, in order, before the ones of a request
+	sources []net.Peer
 }
 
 // sync manager will renew sync if nothing happens for factor*period time
@@ -68,6 +70,8 @@ type SyncConfig struct {
 	BoltdbStore chain.Store
 	Info        *public.Info
 	NodeAddr    string
+	// Sources are the peers to sync from first, in order, before falling back to the peers of a request
+	Sources []net.Peer
 }
 
 // NewSyncManager returns a sync manager that will use the given store to store
@@ -92,6 +96,7 @@ func NewSyncManager(ctx context.Context, c *SyncConfig) (*SyncManager, error) {
 		period:          c.Info.Period,
 		scheme:          sch,
 		nodeAddr:        c.NodeAddr,
+		sources:         c.Sources,
 		factor:          syncExpiryFactor,
 		newReq:          make(chan RequestInfo, syncQueueRequest),
 		newSyncedBeacon: make(chan *commonutils.Beacon, 1),
@@ -338,10 +343,10 @@ func (s *SyncManager) Sync(ctx context.Context, request RequestInfo) error {
 	ctx, span := tracer.NewSpanFromSpanContext(ctx, request.spanContext, "syncManager.Sync")
 	defer span.End()
 
-	s.log.Debugw("starting new sync", "sync_manager", "start sync", "up_to", request.upTo, "nodes", peersToString(request.nodes))
-	// shuffle through the nodes
-	for _, n := range rand.Perm(len(request.nodes)) {
-		if request.nodes[n].Address() == s.nodeAddr {
+	nodes := s.syncOrder(request.nodes)
+	s.log.Debugw("starting new sync", "sync_manager", "start sync", "up_to", request.upTo, "nodes", peersToString(nodes))
+	for _, node := range nodes {
+		if node.Address() == s.nodeAddr {
 			// we ignore our own node
 			s.log.Debugw("skipping sync with our own node", "sync_manager", "sync")
 			continue
@@ -352,7 +357,6 @@ func (s *SyncManager) Sync(ctx context.Context, request RequestInfo) error {
 			s.log.Debugw("sync canceled early", "source", "ctx", "err?", ctx.Err())
 			return fmt.Errorf("ctx done: sync canceled")
 		default:
-			node := request.nodes[n]
 			if s.tryNode(ctx, request.from, request.upTo, node) {
 				// we stop as soon as we've done a successful sync with a node
 				return nil
@@ -363,6 +367,23 @@ func (s *SyncManager) Sync(ctx context.Context, request RequestInfo) error {
 	return ErrFailedAll
 }
 
+// syncOrder returns the peers to sync from, in the order to try them: the configured sources first, then the given
+// nodes shuffled, so that syncs don't always load the same node.
+func (s *SyncManager) syncOrder(nodes []net.Peer) []net.Peer {
+	order := make([]net.Peer, 0, len(s.sources)+len(nodes))
+	seen := make(map[string]bool, len(s.sources))
+	for _, source := range s.sources {
+		order = append(order, source)
+		seen[source.Address()] = true
+	}
+	for _, n := range rand.Perm(len(nodes)) {
+		if !seen[nodes[n].Address()] {
+			order = append(order, nodes[n])
+		}
+	}
+	return order
+}
+
 // tryNode tries to sync up with the given peer up to the given round, starting
 // from the last beacon in the store. It returns true if the objective was
 // reached (store.Last() returns upTo) and false otherwise.
@@ -402,6 +423,13 @@ func (s *SyncManager) tryNode(global context.Context, from, upTo uint64, peer ne
 		logger.Errorw("Invalid request: from > upTo", "from", from, "upTo", upTo)
 		return false
 	}
+	// we log which peer served which range, whether or not the sync went through
+	var lastServed uint64
+	defer func() {
+		if lastServed > 0 {
+			logger.Infow("synced rounds", "from_peer", peer.Address(), "from_round", from, "to_round", lastServed)
+		}
+	}()
 
 	req := &proto.SyncRequest{
 		FromRound: from,
@@ -495,6 +523,7 @@ func (s *SyncManager) tryNode(global context.Context, from, upTo uint64, peer ne
 			s.newSyncedBeacon <- beacon
 
 			last = beacon
+			lastServed = beacon.Round
 			if last.Round == upTo {
 				logger.Debugw("sync_manager finished syncing up to", "round", upTo)
 				span.End()
@@ -15,6 +15,7 @@ import (
 	"github.com/drand/drand/v2/common/log"
 	"github.com/drand/drand/v2/common/testlogger"
 	"github.com/drand/drand/v2/internal/chain/boltdb"
+	dnet "github.com/drand/drand/v2/internal/net"
 	dcontext "github.com/drand/drand/v2/internal/test/context"
 	"github.com/drand/drand/v2/protobuf/drand"
 )
@@ -359,3 +360,30 @@ func doTest(t *testing.T, addr1, addr2 string) {
 		require.Equal(t, 16, stream2.GetCounter())
 	})
 }
+
+func TestSyncOrder(t *testing.T) {
+	peers := func(addrs ...string) []dnet.Peer {
+		p := make([]dnet.Peer, len(addrs))
+		for i, addr := range addrs {
+			p[i] = dnet.CreatePeer(addr)
+		}
+		return p
+	}
+	addresses := func(p []dnet.Peer) []string {
+		a := make([]string, len(p))
+		for i := range p {
+			a[i] = p[i].Address()
+		}
+		return a
+	}
+
+	s := &SyncManager{}
+	require.ElementsMatch(t, []string{"a:1", "b:1", "c:1"}, addresses(s.syncOrder(peers("a:1", "b:1", "c:1"))))
+
+	// the sources come first, in order, and aren't tried twice
+	s.sources = peers("mirror:1", "b:1")
+	order := addresses(s.syncOrder(peers("a:1", "b:1", "c:1")))
+	require.Len(t, order, 4)
+	require.Equal(t, []string{"mirror:1", "b:1"}, order[:2])
+	require.ElementsMatch(t, []string{"a:1", "c:1"}, order[2:])
+}
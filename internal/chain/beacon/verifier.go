@@ -0,0 +1,38 @@
+package beacon
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/drand/drand/v2/internal/metrics"
+)
+
+// partialVerifier bounds how many partial signatures are verified at once. Each partial is verified by the
+// goroutine handling the request it came with, so a burst of partials would otherwise be verified all at once.
+type partialVerifier struct {
+	slots  chan struct{}
+	queued prometheus.Gauge
+}
+
+func newPartialVerifier(beaconID string, workers int) *partialVerifier {
+	return &partialVerifier{
+		slots:  make(chan struct{}, workers),
+		queued: metrics.BeaconPartialVerificationQueue.WithLabelValues(beaconID),
+	}
+}
+
+// verify runs the verification fn once fewer than the maximum number of verifications are running. It returns the
+// error of the context instead if the context is done first.
+func (v *partialVerifier) verify(ctx context.Context, fn func() error) error {
+	v.queued.Inc()
+	select {
+	case v.slots <- struct{}{}:
+		v.queued.Dec()
+	case <-ctx.Done():
+		v.queued.Dec()
+		return ctx.Err()
+	}
+	defer func() { <-v.slots }()
+	return fn()
+}
@@ -0,0 +1,59 @@
+package beacon
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartialVerifier(t *testing.T) {
+	v := newPartialVerifier("partial_verifier", 2)
+	release := make(chan struct{})
+	var running, maxRunning atomic.Int32
+	fn := func() error {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			m := maxRunning.Load()
+			if n <= m || maxRunning.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		<-release
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.NoError(t, v.verify(context.Background(), fn))
+		}()
+	}
+
+	// only 2 verifications run, the others are queued
+	require.Eventually(t, func() bool {
+		return running.Load() == 2 && testutil.ToFloat64(v.queued) == 3
+	}, time.Second, time.Millisecond)
+
+	// a queued verification gives up with its context
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, v.verify(ctx, fn), context.Canceled)
+
+	close(release)
+	wg.Wait()
+	require.Equal(t, int32(2), maxRunning.Load())
+	require.Zero(t, testutil.ToFloat64(v.queued))
+
+	// the error of the verification is returned
+	errInvalid := errors.New("invalid partial")
+	require.ErrorIs(t, v.verify(context.Background(), func() error { return errInvalid }), errInvalid)
+}
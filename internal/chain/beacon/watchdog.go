@@ -0,0 +1,87 @@
+package beacon
+
+import (
+	"context"
+	"time"
+
+	clock "github.com/jonboulle/clockwork"
+
+	"github.com/drand/drand/v2/common/log"
+	"github.com/drand/drand/v2/internal/metrics"
+)
+
+// watchdog reports the beacon loop as stalled when it misses its heartbeats, i.e. when it doesn't handle the tick
+// of a round for a while. It is a local signal, the process can be up and reachable while producing nothing.
+type watchdog struct {
+	l        log.Logger
+	clock    clock.Clock
+	beaconID string
+	// timeout is how long the loop can go without a heartbeat before being reported as stalled
+	timeout time.Duration
+	// onStall is called once the loop is reported as stalled, if set
+	onStall func()
+	beats   chan struct{}
+}
+
+func newWatchdog(l log.Logger, clk clock.Clock, beaconID string, timeout time.Duration, onStall func()) *watchdog {
+	return &watchdog{
+		l:        l,
+		clock:    clk,
+		beaconID: beaconID,
+		timeout:  timeout,
+		onStall:  onStall,
+		beats:    make(chan struct{}, 1),
+	}
+}
+
+// beat records a heartbeat of the beacon loop, it never blocks the loop
+func (w *watchdog) beat() {
+	select {
+	case w.beats <- struct{}{}:
+	default:
+	}
+}
+
+// run watches the heartbeats until the context is canceled. The timeout only starts with the first heartbeat, so
+// that a loop waiting for the genesis time isn't reported as stalled.
+func (w *watchdog) run(ctx context.Context) {
+	stalled := metrics.BeaconStalled.WithLabelValues(w.beaconID)
+	stalled.Set(0)
+	defer stalled.Set(0)
+
+	select {
+	case <-ctx.Done():
+		return
+	case <-w.beats:
+	}
+	timer := w.clock.NewTimer(w.timeout)
+	defer timer.Stop()
+	isStalled := false
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.beats:
+			if isStalled {
+				w.l.Infow("beacon loop recovered")
+				stalled.Set(0)
+				isStalled = false
+			}
+			if !timer.Stop() {
+				// drain a timeout racing with the heartbeat, if it wasn't received yet
+				select {
+				case <-timer.Chan():
+				default:
+				}
+			}
+			timer.Reset(w.timeout)
+		case <-timer.Chan():
+			w.l.Errorw("beacon loop stalled, no round handled", "for", w.timeout)
+			stalled.Set(1)
+			isStalled = true
+			if w.onStall != nil {
+				w.onStall()
+			}
+		}
+	}
+}
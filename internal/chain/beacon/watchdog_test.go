@@ -0,0 +1,62 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	clock "github.com/jonboulle/clockwork"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/v2/common/testlogger"
+	"github.com/drand/drand/v2/internal/metrics"
+)
+
+func TestWatchdog(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	clk := clock.NewFakeClock()
+	stalls := make(chan struct{}, 1)
+	w := newWatchdog(testlogger.New(t), clk, "watchdog", 3*time.Second, func() { stalls <- struct{}{} })
+	gauge := metrics.BeaconStalled.WithLabelValues("watchdog")
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		w.run(ctx)
+	}()
+
+	// the timeout only starts with the first heartbeat
+	clk.Advance(time.Minute)
+	w.beat()
+	clk.BlockUntil(1)
+
+	// heartbeats keep the loop from being reported as stalled
+	for i := 0; i < 3; i++ {
+		clk.Advance(2 * time.Second)
+		w.beat()
+		require.Eventually(t, func() bool { return len(w.beats) == 0 }, time.Second, time.Millisecond)
+	}
+	require.Empty(t, stalls)
+	require.Zero(t, testutil.ToFloat64(gauge))
+
+	// without heartbeats, the loop is reported as stalled once the timeout elapses
+	require.Eventually(t, func() bool {
+		select {
+		case <-stalls:
+			return true
+		default:
+			clk.Advance(time.Second)
+			return false
+		}
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, float64(1), testutil.ToFloat64(gauge))
+
+	// the next heartbeat clears the stall
+	w.beat()
+	require.Eventually(t, func() bool { return testutil.ToFloat64(gauge) == 0 }, time.Second, time.Millisecond)
+
+	cancel()
+	<-done
+}
@@ -3,10 +3,12 @@ package boltdb
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"os"
 	"path"
 	"sync"
+	"time"
 
 	"github.com/drand/drand/v2/common/tracer"
 	"github.com/drand/drand/v2/internal/metrics"
@@ -28,6 +30,8 @@ import (
 type BoltStore struct {
 	sync.Mutex
 	db *bolt.DB
+	// batch makes Put coalesce the concurrent writes, see Options.BatchDelay
+	batch bool
 
 	log log.Logger
 }
@@ -53,6 +57,100 @@ func isThisATest(ctx context.Context) bool {
 	return ok
 }
 
+// readOnlyOpenTimeout bounds how long opening a store read-only waits for the lock held by a running daemon
+const readOnlyOpenTimeout = time.Second
+
+type readOnlyKey struct{}
+
+// ReadOnly returns a context opening the bolt stores read-only, so that tooling can inspect a database
+// without modifying it. The database can't be opened read-only by one process while a running daemon holds it.
+func ReadOnly(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readOnlyKey{}, true)
+}
+
+func isReadOnly(ctx context.Context) bool {
+	readOnly, _ := ctx.Value(readOnlyKey{}).(bool)
+	return readOnly
+}
+
+// Options trade the durability of the bolt stores for their write throughput.
+type Options struct {
+	// NoSync skips the fsync after each write. A crash, or a power loss, can then lose the latest rounds stored,
+	// or corrupt the database. The rounds lost are synced again from the other nodes on restart, but a corrupted
+	// database has to be rebuilt.
+	NoSync bool
+	// BatchDelay, when positive, makes the stores write beacons in batches: concurrent writes are coalesced into a
+	// single transaction, waiting up to BatchDelay for other writes to join. Every write is delayed by up to
+	// BatchDelay.
+	BatchDelay time.Duration
+}
+
+type optionsKey struct{}
+
+// WithOptions returns a context opening the bolt stores with the given options
+func WithOptions(ctx context.Context, opts Options) context.Context {
+	return context.WithValue(ctx, optionsKey{}, opts)
+}
+
+func optionsFromContext(ctx context.Context) Options {
+	opts, _ := ctx.Value(optionsKey{}).(Options)
+	return opts
+}
+
+func openOptions(ctx context.Context) *bolt.Options {
+	if !isReadOnly(ctx) {
+		return nil
+	}
+	return &bolt.Options{ReadOnly: true, Timeout: readOnlyOpenTimeout}
+}
+
+// update runs fn in a read-write transaction, batched with the concurrent writes if the database has a batch delay
+func update(db *bolt.DB, batch bool, fn func(*bolt.Tx) error) error {
+	if batch {
+		return db.Batch(fn)
+	}
+	return db.Update(fn)
+}
+
+// openDB opens the bolt database at dbPath and makes sure it has a beacon bucket, creating it unless the
+// context asks for a read-only database.
+func openDB(ctx context.Context, dbPath string) (*bolt.DB, error) {
+	db, err := bolt.Open(dbPath, BoltStoreOpenPerm, openOptions(ctx))
+	if errors.Is(err, bolt.ErrTimeout) {
+		return nil, fmt.Errorf("database %s is locked, is the daemon running? %w", dbPath, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	opts := optionsFromContext(ctx)
+	db.NoSync = opts.NoSync
+	if opts.BatchDelay > 0 {
+		db.MaxBatchDelay = opts.BatchDelay
+	}
+
+	if isReadOnly(ctx) {
+		err = db.View(func(tx *bolt.Tx) error {
+			if tx.Bucket(beaconBucket) == nil {
+				return fmt.Errorf("database %s has no beacon", dbPath)
+			}
+			return nil
+		})
+		if err != nil {
+			_ = db.Close()
+			return nil, err
+		}
+		return db, nil
+	}
+
+	// create the bucket already
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(beaconBucket)
+		return err
+	})
+	return db, err
+}
+
 // NewBoltStore returns a Store implementation using the boltdb storage engine.
 func NewBoltStore(ctx context.Context, l log.Logger, folder string) (chain.Store, error) {
 	ctx, span := tracer.NewSpan(ctx, "boltStore.NewBoltStore")
@@ -83,19 +181,15 @@ func NewBoltStore(ctx context.Context, l log.Logger, folder string) (chain.Store
 		WithLabelValues(beaconID, "bolt-untrimmed").
 		Set(float64(chain.BoltUntrimmedMetrics))
 
-	db, err := bolt.Open(dbPath, BoltStoreOpenPerm, nil)
-	if err != nil {
+	db, err := openDB(ctx, dbPath)
+	if db == nil {
 		return nil, err
 	}
-	// create the bucket already
-	err = db.Update(func(tx *bolt.Tx) error {
-		_, err := tx.CreateBucketIfNotExists(beaconBucket)
-		return err
-	})
 
 	return &BoltStore{
-		log: l,
-		db:  db,
+		log:   l,
+		db:    db,
+		batch: optionsFromContext(ctx).BatchDelay > 0,
 	}, err
 }
 
@@ -113,7 +207,7 @@ func shouldUseTrimmedBolt(ctx context.Context, l log.Logger, sourceBeaconPath st
 	}
 
 	// Existing beacon stores should use the format that's suitable
-	existingDB, err := bolt.Open(sourceBeaconPath, BoltStoreOpenPerm, nil)
+	existingDB, err := bolt.Open(sourceBeaconPath, BoltStoreOpenPerm, openOptions(ctx))
 	if err != nil {
 		l.Errorw("while trying to open existing bolt database", "err", err)
 		return true
@@ -178,7 +272,7 @@ func (b *BoltStore) Put(ctx context.Context, beacon *common.Beacon) error {
 	default:
 	}
 
-	return b.db.Update(func(tx *bolt.Tx) error {
+	return update(b.db, b.batch, func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(beaconBucket)
 		key := chain.RoundToBytes(beacon.Round)
 		buff, err := beacon.Marshal()
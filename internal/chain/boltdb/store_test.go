@@ -3,8 +3,11 @@ package boltdb
 import (
 	"context"
 	"errors"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/drand/drand/v2/common"
@@ -244,3 +247,39 @@ func TestShouldUseTrimmedBolt(t *testing.T) {
 		})
 	}
 }
+
+func TestStoreBoltOptions(t *testing.T) {
+	ctx := WithOptions(IsATest(context.Background()), Options{NoSync: true, BatchDelay: 5 * time.Millisecond})
+	l := testlogger.New(t)
+	s, err := NewBoltStore(ctx, l, t.TempDir())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, s.Close())
+	}()
+	store := s.(*BoltStore)
+	require.True(t, store.db.NoSync)
+	require.Equal(t, 5*time.Millisecond, store.db.MaxBatchDelay)
+	require.True(t, store.batch)
+
+	// concurrent writes are batched together
+	var wg sync.WaitGroup
+	for round := uint64(1); round <= 20; round++ {
+		wg.Add(1)
+		go func(round uint64) {
+			defer wg.Done()
+			assert.NoError(t, store.Put(ctx, &common.Beacon{Round: round, Signature: []byte{byte(round)}}))
+		}(round)
+	}
+	wg.Wait()
+	length, err := store.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 20, length)
+
+	// the default options keep every write synced
+	s, err = NewBoltStore(IsATest(context.Background()), l, t.TempDir())
+	require.NoError(t, err)
+	defaultStore := s.(*BoltStore)
+	require.False(t, defaultStore.db.NoSync)
+	require.False(t, defaultStore.batch)
+	require.NoError(t, defaultStore.Close())
+}
@@ -23,6 +23,8 @@ import (
 type trimmedStore struct {
 	sync.Mutex
 	db *bolt.DB
+	// batch makes Put coalesce the concurrent writes, see Options.BatchDelay
+	batch bool
 
 	log log.Logger
 
@@ -43,19 +45,15 @@ func newTrimmedStore(ctx context.Context, l log.Logger, folder string) (*trimmed
 	}
 
 	dbPath := path.Join(folder, BoltFileName)
-	db, err := bolt.Open(dbPath, BoltStoreOpenPerm, nil)
-	if err != nil {
+	db, err := openDB(ctx, dbPath)
+	if db == nil {
 		return nil, err
 	}
-	// create the bucket already
-	err = db.Update(func(tx *bolt.Tx) error {
-		_, err := tx.CreateBucketIfNotExists(beaconBucket)
-		return err
-	})
 
 	return &trimmedStore{
-		log: l,
-		db:  db,
+		log:   l,
+		db:    db,
+		batch: optionsFromContext(ctx).BatchDelay > 0,
 
 		requiresPrevious: chain.PreviousRequiredFromContext(ctx),
 	}, err
@@ -107,7 +105,7 @@ func (b *trimmedStore) Put(ctx context.Context, beacon *common.Beacon) error {
 	default:
 	}
 
-	return b.db.Update(func(tx *bolt.Tx) error {
+	return update(b.db, b.batch, func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(beaconBucket)
 
 		// We know this will be an append-only workload, so let's use a compact db.
@@ -6,12 +6,16 @@ import (
 	"io"
 	"sort"
 	"sync"
+	"time"
+
+	clock "github.com/jonboulle/clockwork"
 
 	"github.com/drand/drand/v2/common/tracer"
 
 	"github.com/drand/drand/v2/common"
 	"github.com/drand/drand/v2/internal/chain"
 	"github.com/drand/drand/v2/internal/chain/errors"
+	"github.com/drand/drand/v2/internal/metrics"
 )
 
 // Store represents access to the in-memory storage for beacon management.
@@ -19,21 +23,71 @@ type Store struct {
 	storeMtx   *sync.RWMutex
 	store      []*common.Beacon
 	bufferSize int
+	// retention, when set, is the age after which the beacon of a round is evicted
+	retention time.Duration
+	genesis   int64
+	period    time.Duration
+	clock     clock.Clock
+	// metricsBeaconID, when set, is the beacon id the stats of the store are reported under
+	metricsBeaconID string
+}
+
+// beaconOverhead is an estimate of the memory used by a stored beacon besides its signatures: the Beacon
+// struct itself, made of two slice headers and the round, and its pointer in the store.
+const beaconOverhead = 64
+
+// Stats describes the content of the store
+type Stats struct {
+	// Rounds is the number of rounds held by the store
+	Rounds int
+	// Oldest and Newest are the first and last rounds held by the store, both are zero if it is empty
+	Oldest uint64
+	Newest uint64
+	// EstimatedBytes is an estimate of the memory used by the beacons held by the store
+	EstimatedBytes int
+}
+
+// Option configures optional behaviours of the Store.
+type Option func(*Store)
+
+// WithRetention evicts the beacons of rounds older than the retention window, in addition to the size cap.
+// The time of a round is derived from the genesis time and the period of the chain. Eviction happens when
+// new beacons are stored, and the most recent beacon is never evicted, so that Last keeps returning it and
+// the next beacon of a chained scheme can be verified against it.
+func WithRetention(retention time.Duration, genesis int64, period time.Duration, clk clock.Clock) Option {
+	return func(s *Store) {
+		s.retention = retention
+		s.genesis = genesis
+		s.period = period
+		s.clock = clk
+	}
+}
+
+// WithStatsMetrics reports the number of rounds held by the store and its estimated memory footprint as metrics
+// of the given beacon id, updated each time a beacon is stored.
+func WithStatsMetrics(beaconID string) Option {
+	return func(s *Store) {
+		s.metricsBeaconID = beaconID
+	}
 }
 
 // NewStore returns a new store that provides the CRUD based API needed for
 // supporting drand serialization.
-func NewStore(bufferSize int) *Store {
+func NewStore(bufferSize int, opts ...Option) *Store {
 	//nolint:mnd // We want to have a guard here. And it's number 10. It's higher than 1 or 2 to allow for chained mode
 	if bufferSize < 10 {
 		err := fmt.Errorf("in-memory buffer size cannot be smaller than 10, currently %d, recommended at least 2000", bufferSize)
 		panic(err)
 	}
-	return &Store{
+	s := &Store{
 		storeMtx:   &sync.RWMutex{},
 		store:      make([]*common.Beacon, 0, bufferSize),
 		bufferSize: bufferSize,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 func (s *Store) Len(ctx context.Context) (int, error) {
@@ -56,6 +110,12 @@ func (s *Store) Put(ctx context.Context, beacon *common.Beacon) error {
 		if len(s.store) > s.bufferSize {
 			s.store = s.store[len(s.store)-s.bufferSize:]
 		}
+		s.evictExpired()
+		if s.metricsBeaconID != "" {
+			stats := s.stats()
+			metrics.MemDBRounds.WithLabelValues(s.metricsBeaconID).Set(float64(stats.Rounds))
+			metrics.MemDBEstimatedBytes.WithLabelValues(s.metricsBeaconID).Set(float64(stats.EstimatedBytes))
+		}
 	}()
 
 	for _, sb := range s.store {
@@ -79,6 +139,47 @@ func (s *Store) Put(ctx context.Context, beacon *common.Beacon) error {
 	return nil
 }
 
+// evictExpired drops the beacons older than the retention window, keeping at least the most recent one.
+// It requires the store lock.
+func (s *Store) evictExpired() {
+	if s.retention <= 0 || len(s.store) <= 1 {
+		return
+	}
+
+	cutoff := s.clock.Now().Add(-s.retention).Unix()
+	expired := 0
+	for expired < len(s.store)-1 && common.TimeOfRound(s.period, s.genesis, s.store[expired].Round) < cutoff {
+		expired++
+	}
+	if expired > 0 {
+		// we copy the remaining beacons so the evicted ones can be garbage collected
+		s.store = append(make([]*common.Beacon, 0, s.bufferSize), s.store[expired:]...)
+	}
+}
+
+// Stats returns the number of rounds held by the store, its oldest and newest rounds and an estimate of the
+// memory they use, to help sizing the store.
+func (s *Store) Stats() Stats {
+	s.storeMtx.RLock()
+	defer s.storeMtx.RUnlock()
+
+	return s.stats()
+}
+
+// stats requires the store lock
+func (s *Store) stats() Stats {
+	stats := Stats{Rounds: len(s.store)}
+	if len(s.store) == 0 {
+		return stats
+	}
+	stats.Oldest = s.store[0].Round
+	stats.Newest = s.store[len(s.store)-1].Round
+	for _, b := range s.store {
+		stats.EstimatedBytes += beaconOverhead + len(b.Signature) + len(b.PreviousSig)
+	}
+	return stats
+}
+
 func (s *Store) Last(ctx context.Context) (*common.Beacon, error) {
 	_, span := tracer.NewSpan(ctx, "memDB.Last")
 	defer span.End()
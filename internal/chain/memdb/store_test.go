@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	clock "github.com/jonboulle/clockwork"
 	"github.com/stretchr/testify/require"
 
 	"github.com/drand/drand/v2/common"
@@ -267,3 +268,71 @@ func TestStore_Put(t *testing.T) {
 		})
 	}
 }
+
+func TestStoreRetention(t *testing.T) {
+	ctx := context.Background()
+	period := 3 * time.Second
+	genesis := time.Now().Unix()
+	clk := clock.NewFakeClockAt(time.Unix(genesis, 0))
+	// we keep one minute of rounds, that's 20 rounds, way less than the size cap
+	s := memdb.NewStore(1000, memdb.WithRetention(time.Minute, genesis, period, clk))
+
+	put := func(round uint64) {
+		require.NoError(t, s.Put(ctx, &common.Beacon{Round: round, Signature: []byte{byte(round)}}))
+	}
+
+	for round := uint64(1); round <= 40; round++ {
+		clk.Advance(period)
+		put(round)
+	}
+
+	// round 41 is due now, so round 21 is the oldest one within the window
+	sLen, err := s.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 20, sLen)
+
+	_, err = s.Get(ctx, 20)
+	require.ErrorIs(t, err, chainerrors.ErrNoBeaconStored)
+	b, err := s.Get(ctx, 21)
+	require.NoError(t, err)
+	require.Equal(t, uint64(21), b.Round)
+
+	err = s.Cursor(ctx, func(ctx context.Context, c chain.Cursor) error {
+		first, err := c.First(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint64(21), first.Round)
+		return nil
+	})
+	require.NoError(t, err)
+
+	// the most recent beacon is kept even when it's older than the window
+	clk.Advance(time.Hour)
+	put(39)
+	last, err := s.Last(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(40), last.Round)
+	sLen, err = s.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, sLen)
+}
+
+func TestStoreStats(t *testing.T) {
+	ctx := context.Background()
+	s := memdb.NewStore(10)
+	require.Equal(t, memdb.Stats{}, s.Stats())
+
+	for round := uint64(5); round <= 20; round++ {
+		require.NoError(t, s.Put(ctx, &common.Beacon{
+			Round:       round,
+			Signature:   make([]byte, 96),
+			PreviousSig: make([]byte, 96),
+		}))
+	}
+
+	// the store only holds the last 10 rounds
+	stats := s.Stats()
+	require.Equal(t, 10, stats.Rounds)
+	require.Equal(t, uint64(11), stats.Oldest)
+	require.Equal(t, uint64(20), stats.Newest)
+	require.Greater(t, stats.EstimatedBytes, 10*2*96)
+}
@@ -24,7 +24,9 @@ type Config struct {
 	ConnectTimeout int
 	MaxIdleConns   int
 	MaxOpenConns   int
-	DisableTLS     bool
+	// ConnMaxLifetime is the maximum amount of time a connection may be reused, 0 meaning forever
+	ConnMaxLifetime time.Duration
+	DisableTLS      bool
 }
 
 // ConfigFromDSN provides support for creating a config from a DSN.
@@ -91,9 +93,29 @@ func ConfigFromDSN(dsn string) (Config, error) {
 		}
 	}
 
+	if query.Has("max-lifetime") {
+		lifetime := query.Get("max-lifetime")
+		l, err := time.ParseDuration(lifetime)
+		if err != nil {
+			return Config{}, fmt.Errorf("expected duration for max-lifetime, got err: %w", err)
+		}
+		if l >= 0 {
+			cfg.ConnMaxLifetime = l
+		}
+	}
+
 	return cfg, nil
 }
 
+// SetPool tunes the connection pool of the database handle, using the semantics of database/sql:
+// a maxOpen <= 0 means no limit on open connections, a maxIdle <= 0 means no idle connections are kept
+// and a maxLifetime <= 0 means connections are reused forever.
+func SetPool(db *sqlx.DB, maxOpen, maxIdle int, maxLifetime time.Duration) {
+	db.SetMaxOpenConns(maxOpen)
+	db.SetMaxIdleConns(maxIdle)
+	db.SetConnMaxLifetime(maxLifetime)
+}
+
 // Open knows how to open a database connection based on the configuration.
 // It also performs a health check to make sure the connection is healthy.
 //
@@ -124,8 +146,7 @@ func Open(ctx context.Context, cfg Config) (*sqlx.DB, error) {
 	if err != nil {
 		return nil, err
 	}
-	db.SetMaxIdleConns(cfg.MaxIdleConns)
-	db.SetMaxOpenConns(cfg.MaxOpenConns)
+	SetPool(db, cfg.MaxOpenConns, cfg.MaxIdleConns, cfg.ConnMaxLifetime)
 
 	return db, StatusCheck(ctx, db)
 }
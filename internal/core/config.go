@@ -11,10 +11,14 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/drand/drand/v2/common"
+	public "github.com/drand/drand/v2/common/chain"
 	"github.com/drand/drand/v2/common/key"
 	"github.com/drand/drand/v2/common/log"
 	"github.com/drand/drand/v2/internal/chain"
+	"github.com/drand/drand/v2/internal/chain/boltdb"
 	"github.com/drand/drand/v2/internal/chain/postgresdb/database"
+	"github.com/drand/drand/v2/internal/metrics"
+	"github.com/drand/drand/v2/internal/net"
 )
 
 // ConfigOption is a function that applies a specific setting to a Config.
@@ -25,22 +29,42 @@ type Config struct {
 	configFolder          string
 	version               string
 	privateListenAddr     string
+	advertiseAddr         string
 	publicListenAddr      string
 	controlPort           string
 	dbStorageEngine       chain.StorageType
 	dkgTimeout            time.Duration
 	dkgKickoffGracePeriod time.Duration
 	dkgPhaseTimeout       time.Duration
+	dkgBroadcastRetries   int
+	dkgRetryInterval      time.Duration
 	grpcOpts              []grpc.DialOption
 	callOpts              []grpc.CallOption
 	pgDSN                 string
 	pgConn                *sqlx.DB
+	pgPool                func(*sqlx.DB)
 	memDBSize             int
+	memDBRetention        time.Duration
+	boltOptions           boltdb.Options
+	metricsServer         metrics.ServerOptions
 	dkgCallback           func(context.Context, *key.Group)
 	logger                log.Logger
 	clock                 clock.Clock
 	tracesEndpoint        string
 	tracesProbability     float64
+	grpcWeb               bool
+	freshnessHeaders      bool
+	rateLimit             float64
+	rateBurst             int
+	rateLimitTrustProxy   bool
+	httpRangeMaxRounds    uint64
+	streamDrainPeriod     time.Duration
+	stallPeriods          int
+	onStall               func()
+	partialVerifiers      int
+	readOnly              bool
+	syncSources           []string
+	mirrorCallback        func(context.Context, string, *public.Info)
 }
 
 // NewConfig returns the config to pass to drand with the default options set
@@ -51,7 +75,10 @@ func NewConfig(l log.Logger, opts ...ConfigOption) *Config {
 		dkgTimeout:            DefaultDKGPhaseTimeout,
 		dkgKickoffGracePeriod: DefaultDKGKickoffGracePeriod,
 		dkgPhaseTimeout:       DefaultDKGPhaseTimeout,
+		dkgBroadcastRetries:   DefaultDKGBroadcastRetries,
 		controlPort:           DefaultControlPort,
+		streamDrainPeriod:     DefaultStreamDrainPeriod,
+		stallPeriods:          DefaultStallPeriods,
 		logger:                l,
 		clock:                 clock.NewRealClock(),
 	}
@@ -88,6 +115,15 @@ func (d *Config) PrivateListenAddress(defaultAddr string) string {
 	return defaultAddr
 }
 
+// AdvertiseAddress returns the given default address or the address stored in the config thanks to
+// WithAdvertiseAddress, that this node advertises to other nodes in its identity
+func (d *Config) AdvertiseAddress(defaultAddr string) string {
+	if d.advertiseAddr != "" {
+		return d.advertiseAddr
+	}
+	return defaultAddr
+}
+
 // PublicListenAddress returns the given default address or the listen address stored
 // in the config thanks to WithPublicListenAddress
 func (d *Config) PublicListenAddress(defaultAddr string) string {
@@ -108,6 +144,36 @@ func (d *Config) ControlPort() string {
 	return d.controlPort
 }
 
+// StreamDrainPeriod returns how long the daemon waits for the public randomness streams to end when it stops
+func (d *Config) StreamDrainPeriod() time.Duration {
+	return d.streamDrainPeriod
+}
+
+// StallPeriods returns after how many periods without handling a round the beacon loops are reported as stalled
+func (d *Config) StallPeriods() int {
+	return d.stallPeriods
+}
+
+// PartialVerifiers returns the maximum number of partial signatures each beacon verifies at once, 0 meaning
+// GOMAXPROCS
+func (d *Config) PartialVerifiers() int {
+	return d.partialVerifiers
+}
+
+// ReadOnly tells whether the daemon only serves the chains it follows, see WithReadOnly
+func (d *Config) ReadOnly() bool {
+	return d.readOnly
+}
+
+// SyncSources returns the peers the node syncs from first, set with WithSyncSources
+func (d *Config) SyncSources() []net.Peer {
+	peers := make([]net.Peer, len(d.syncSources))
+	for i, addr := range d.syncSources {
+		peers[i] = net.CreatePeer(addr)
+	}
+	return peers
+}
+
 // Logger returns the logger associated with this config.
 func (d *Config) Logger() log.Logger {
 	return d.logger
@@ -139,6 +205,22 @@ func WithDkgPhaseTimeout(t time.Duration) ConfigOption {
 	}
 }
 
+// WithDkgBroadcastRetries sets how many times a DKG packet is resent to a participant that failed to receive it.
+// Zero disables the retransmissions.
+func WithDkgBroadcastRetries(retries int) ConfigOption {
+	return func(d *Config) {
+		d.dkgBroadcastRetries = retries
+	}
+}
+
+// WithDkgBroadcastRetryInterval sets the time between two attempts at sending a DKG packet to a participant. By
+// default, the retries are spread over a DKG phase.
+func WithDkgBroadcastRetryInterval(t time.Duration) ConfigOption {
+	return func(d *Config) {
+		d.dkgRetryInterval = t
+	}
+}
+
 // WithDBStorageEngine allows setting the specific storage type
 func WithDBStorageEngine(engine chain.StorageType) ConfigOption {
 	return func(d *Config) {
@@ -146,6 +228,11 @@ func WithDBStorageEngine(engine chain.StorageType) ConfigOption {
 	}
 }
 
+// StorageEngine returns the storage engine of the beacon stores
+func (d *Config) StorageEngine() chain.StorageType {
+	return d.dbStorageEngine
+}
+
 // WithPgDSN applies PosgresSQL specific options to the PG store.
 // It will also create a new database connection.
 func WithPgDSN(dsn string) ConfigOption {
@@ -171,6 +258,24 @@ func WithPgDSN(dsn string) ConfigOption {
 			err := fmt.Errorf("error while attempting to connect to the database: dsn: %s %w", dsn, err)
 			panic(err)
 		}
+		if d.pgPool != nil {
+			d.pgPool(d.pgConn)
+		}
+	}
+}
+
+// WithPgPoolConfig tunes the connection pool of the PostgreSQL store, overriding the pool settings of the DSN.
+// A maxOpen <= 0 means no limit on open connections, a maxIdle <= 0 means no idle connections are kept and
+// a maxLifetime <= 0 means connections are reused forever.
+// It can be given before or after WithPgDSN.
+func WithPgPoolConfig(maxOpen, maxIdle int, maxLifetime time.Duration) ConfigOption {
+	return func(d *Config) {
+		d.pgPool = func(db *sqlx.DB) {
+			database.SetPool(db, maxOpen, maxIdle, maxLifetime)
+		}
+		if d.pgConn != nil {
+			d.pgPool(d.pgConn)
+		}
 	}
 }
 
@@ -190,6 +295,49 @@ func WithMemDBSize(bufferSize int) ConfigOption {
 	}
 }
 
+// WithMemDBRetention makes the in-memory store evict the beacons of rounds older than the given duration,
+// in addition to the size cap set with WithMemDBSize. A retention of 0 only applies the size cap.
+func WithMemDBRetention(retention time.Duration) ConfigOption {
+	return func(d *Config) {
+		d.memDBRetention = retention
+	}
+}
+
+// WithBoltOptions trades the durability of the bolt store for its write throughput, e.g. for chains with short
+// periods or test environments. With noSync, the store doesn't fsync after each write: a crash can lose the latest
+// rounds, which are synced again from the other nodes on restart, or even corrupt the database. A positive
+// batchDelay coalesces the concurrent writes into single transactions, delaying each write by up to batchDelay.
+func WithBoltOptions(noSync bool, batchDelay time.Duration) ConfigOption {
+	return func(d *Config) {
+		d.boltOptions = boltdb.Options{NoSync: noSync, BatchDelay: batchDelay}
+	}
+}
+
+// BoltOptions returns the options the bolt store is opened with
+func (d *Config) BoltOptions() boltdb.Options {
+	return d.boltOptions
+}
+
+// WithMetricsTLS serves the metrics over TLS, with the certificate and key of the given PEM files
+func WithMetricsTLS(certFile, keyFile string) ConfigOption {
+	return func(d *Config) {
+		d.metricsServer.CertFile = certFile
+		d.metricsServer.KeyFile = keyFile
+	}
+}
+
+// WithMetricsToken requires the metrics scrapers to authenticate with the given bearer token
+func WithMetricsToken(token string) ConfigOption {
+	return func(d *Config) {
+		d.metricsServer.BearerToken = token
+	}
+}
+
+// MetricsServerOptions returns how the metrics server is secured
+func (d *Config) MetricsServerOptions() metrics.ServerOptions {
+	return d.metricsServer
+}
+
 // WithConfigFolder sets the base configuration folder to the given string.
 func WithConfigFolder(folder string) ConfigOption {
 	return func(d *Config) {
@@ -215,6 +363,94 @@ func WithPrivateListenAddress(addr string) ConfigOption {
 	}
 }
 
+// WithAdvertiseAddress sets the address recorded in the identity of this node, and thus in the group file, in place
+// of the one stored in its key pair. It lets a node bind its private API on an address that other nodes can't
+// reach, e.g. behind a NAT or in a container.
+func WithAdvertiseAddress(addr string) ConfigOption {
+	return func(d *Config) {
+		d.advertiseAddr = addr
+	}
+}
+
+// WithGRPCWeb enables serving the public gRPC API over gRPC-Web on the public
+// listen address, alongside the HTTP JSON API.
+func WithGRPCWeb(enabled bool) ConfigOption {
+	return func(d *Config) {
+		d.grpcWeb = enabled
+	}
+}
+
+// WithFreshnessHeaders enables headers carrying the server time and latest known
+// round on the HTTP randomness responses.
+func WithFreshnessHeaders(enabled bool) ConfigOption {
+	return func(d *Config) {
+		d.freshnessHeaders = enabled
+	}
+}
+
+// WithRateLimit limits the HTTP requests of each client IP to rps per second, with bursts of up to burst
+// requests. If trustProxy is set, the client IP is read from the X-Forwarded-For header of a proxy in front of
+// the node. A rate of 0 disables the limit.
+func WithRateLimit(rps float64, burst int, trustProxy bool) ConfigOption {
+	return func(d *Config) {
+		d.rateLimit = rps
+		d.rateBurst = burst
+		d.rateLimitTrustProxy = trustProxy
+	}
+}
+
+// WithHTTPRangeMaxRounds sets how many rounds a single request of the HTTP range endpoint can span, instead of
+// dhttp.DefaultRangeMaxRounds.
+func WithHTTPRangeMaxRounds(n uint64) ConfigOption {
+	return func(d *Config) {
+		d.httpRangeMaxRounds = n
+	}
+}
+
+// WithStallWatchdog sets after how many periods without handling a round the beacon loops are reported as stalled:
+// an error is logged, the drand_beacon_stalled gauge is set and onStall, if not nil, is called, e.g. to exit so that
+// a supervisor restarts the daemon. A number of periods of 0 disables the watchdog.
+func WithStallWatchdog(periods int, onStall func()) ConfigOption {
+	return func(d *Config) {
+		d.stallPeriods = periods
+		d.onStall = onStall
+	}
+}
+
+// WithPartialVerifiers sets the maximum number of partial signatures each beacon verifies at once, to cap the CPU
+// the verifications of a round take on nodes of large groups. It defaults to GOMAXPROCS.
+func WithPartialVerifiers(n int) ConfigOption {
+	return func(d *Config) {
+		d.partialVerifiers = n
+	}
+}
+
+// WithStreamDrainPeriod sets how long the daemon waits, when stopping, for the public randomness streams to end
+// after telling their clients it is shutting down. Streams still open after that are closed abruptly.
+func WithStreamDrainPeriod(period time.Duration) ConfigOption {
+	return func(d *Config) {
+		d.streamDrainPeriod = period
+	}
+}
+
+// WithReadOnly makes the daemon a read-only replica: it never takes part in a DKG nor generates beacons, and serves
+// the public API for the chains it follows instead, verifying and storing the rounds it syncs. A followed chain is
+// served again after a restart, and kept in sync from the sources set with WithSyncSources.
+func WithReadOnly() ConfigOption {
+	return func(d *Config) {
+		d.readOnly = true
+	}
+}
+
+// WithSyncSources sets the addresses of the nodes to sync missing rounds from first, in the given order, before
+// falling back to the other nodes of the group. It lets nodes catch up from a node with more bandwidth, or from a
+// read-only mirror, rather than loading the group.
+func WithSyncSources(addrs []string) ConfigOption {
+	return func(d *Config) {
+		d.syncSources = addrs
+	}
+}
+
 // WithControlPort specifies which port on localhost the ListenerControl should
 // bind to.
 func WithControlPort(port string) ConfigOption {
@@ -34,7 +34,22 @@ const DefaultDKGPhaseTimeout = 10 * time.Second
 // receiving the execution notification from the leader.
 const DefaultDKGKickoffGracePeriod = 5 * time.Second
 
+// DefaultDKGBroadcastRetries is the number of times a DKG packet is resent to a
+// participant that failed to receive it.
+const DefaultDKGBroadcastRetries = 3
+
 // DefaultDKGTimeout is the maxiamount of time from start of a DKG until it gets aborted automatically
 const DefaultDKGTimeout = 24 * time.Hour
 
+// DefaultStreamDrainPeriod is the default time the daemon waits for the public randomness streams to end when it
+// stops.
+const DefaultStreamDrainPeriod = 2 * time.Second
+
+// DefaultStallPeriods is the default number of periods a beacon loop can go without handling a round before being
+// reported as stalled.
+const DefaultStallPeriods = 5
+
 const callMaxTimeout = 10 * time.Second
+
+// stopReplyMargin is the time kept aside from the caller's deadline when stopping, to reply before it elapses.
+const stopReplyMargin = 500 * time.Millisecond
@@ -43,7 +43,9 @@ type BeaconProcess struct {
 	dbStore     chain.Store
 	privGateway *net.PrivateGateway
 
-	beacon          *beacon.Handler
+	beacon *beacon.Handler
+	// the chain served by a read-only node, nil otherwise
+	mirror          *mirror
 	completedDKGs   chan dkg.SharingOutput
 	closeDKGChannel func()
 
@@ -87,6 +89,8 @@ func NewBeaconProcess(ctx context.Context,
 		return nil, err
 	}
 
+	priv.Public.Addr = opts.AdvertiseAddress(priv.Public.Addr)
+
 	dkgCh := completedDKGs.Listen()
 	bp := &BeaconProcess{
 		beaconID:      common.GetCanonicalBeaconID(beaconID),
@@ -350,7 +354,9 @@ func (bp *BeaconProcess) WaitExit() chan bool {
 	return bp.exitCh
 }
 
-func (bp *BeaconProcess) createDBStore(ctx context.Context) (chain.Store, error) {
+// createDBStore opens the store of the chain starting at genesis and producing a round every period, which the
+// memdb retention window is computed with.
+func (bp *BeaconProcess) createDBStore(ctx context.Context, genesis int64, period time.Duration) (chain.Store, error) {
 	ctx, span := tracer.NewSpan(ctx, "bp.createDBStore")
 	defer span.End()
 
@@ -368,14 +374,18 @@ func (bp *BeaconProcess) createDBStore(ctx context.Context) (chain.Store, error)
 		dbPath := bp.opts.DBFolder(beaconName)
 		fs.CreateSecureFolder(dbPath)
 		// metrics are set in the NewBoltStore since there are two types, trimmed and untrimmed
-		dbStore, err = boltdb.NewBoltStore(ctx, bp.log, dbPath)
+		dbStore, err = boltdb.NewBoltStore(boltdb.WithOptions(ctx, bp.opts.BoltOptions()), bp.log, dbPath)
 
 	case chain.MemDB:
 		metrics.DrandStorageBackend.
 			WithLabelValues(beaconName, "memdb").
 			Set(float64(chain.MemDBMetrics))
 
-		dbStore, err = memdb.NewStore(bp.opts.memDBSize), nil
+		memOpts := []memdb.Option{memdb.WithStatsMetrics(beaconName)}
+		if bp.opts.memDBRetention > 0 {
+			memOpts = append(memOpts, memdb.WithRetention(bp.opts.memDBRetention, genesis, period, bp.opts.clock))
+		}
+		dbStore, err = memdb.NewStore(bp.opts.memDBSize, memOpts...), nil
 
 	case chain.PostgreSQL:
 		metrics.DrandStorageBackend.
@@ -407,16 +417,20 @@ func (bp *BeaconProcess) newBeacon(ctx context.Context) (*beacon.Handler, error)
 		return nil, fmt.Errorf("public key %s not found in group", pub)
 	}
 
-	store, err := bp.createDBStore(ctx)
+	store, err := bp.createDBStore(ctx, bp.group.GenesisTime, bp.group.Period)
 	if err != nil {
 		return nil, err
 	}
 
 	conf := &beacon.Config{
-		Public: node,
-		Group:  bp.group,
-		Share:  bp.share,
-		Clock:  bp.opts.clock,
+		Public:           node,
+		Group:            bp.group,
+		Share:            bp.share,
+		Clock:            bp.opts.clock,
+		SyncSources:      bp.opts.SyncSources(),
+		StallPeriods:     bp.opts.StallPeriods(),
+		OnStall:          bp.opts.onStall,
+		PartialVerifiers: bp.opts.PartialVerifiers(),
 	}
 
 	if bp.opts.dbStorageEngine == chain.MemDB {
@@ -468,6 +482,13 @@ func (bp *BeaconProcess) StopBeacon(ctx context.Context) {
 	defer bp.state.Unlock()
 
 	bp.closeDKGChannel()
+	if bp.mirror != nil {
+		if bp.mirror.stopSync != nil {
+			bp.mirror.stopSync()
+		}
+		_ = bp.mirror.store.Close()
+		bp.mirror = nil
+	}
 	if bp.beacon == nil {
 		return
 	}
@@ -43,7 +43,7 @@ func (bp *BeaconProcess) PublicKey(ctx context.Context, _ *drand.PublicKeyReques
 
 	return &drand.PublicKeyResponse{
 		PubKey:     protoKey,
-		Addr:       keyPair.Public.Addr,
+		Addr:       bp.opts.AdvertiseAddress(keyPair.Public.Addr),
 		Signature:  keyPair.Public.Signature,
 		Metadata:   bp.newMetadata(),
 		SchemeName: keyPair.Public.Scheme.Name,
@@ -321,33 +321,29 @@ func (bp *BeaconProcess) StartFollowChain(ctx context.Context, req *drand.StartS
 		return errors.New("invalid beacon id on chain info")
 	}
 
-	store, err := bp.createDBStore(context.Background())
-	if err != nil {
-		logger.Errorw("", "start_follow_chain", "unable to create store", "err", err)
-		return fmt.Errorf("unable to create store: %w", err)
-	}
-
-	// TODO find a better place to put that
-	if err := store.Put(ctx, chain.GenesisBeacon(info.GenesisSeed)); err != nil {
-		logger.Errorw("", "start_follow_chain", "unable to insert genesis block", "err", err)
-		store.Close()
-		return fmt.Errorf("unable to insert genesis block: %w", err)
-	}
-
-	// add sch store to handle sch configuration on beacon storing process correctly
-	sch, err := crypto.SchemeFromName(info.GetSchemeName())
-	if err != nil {
-		return err
-	}
-	ss, err := beacon.NewSchemeStore(ctx, store, sch)
-	if err != nil {
-		return err
+	// a read-only node keeps serving the chain it follows once the follow ends, so the store stays open
+	var store chain.Store
+	var cbStore beacon.CallbackStore
+	if bp.opts.readOnly {
+		m, err := bp.mirrorChain(ctx, info)
+		if err != nil {
+			logger.Errorw("", "start_follow_chain", "unable to mirror the chain", "err", err)
+			return err
+		}
+		store, cbStore = m.dbStore, m.store
+		// the follow syncs the mirror in place of its sync sources until it is done
+		bp.stopMirrorSync(m)
+		defer bp.startMirrorSync(m)
+	} else {
+		store, cbStore, err = bp.newFollowStore(ctx, info)
+		if err != nil {
+			logger.Errorw("", "start_follow_chain", "unable to create store", "err", err)
+			return err
+		}
+		defer cbStore.Close()
 	}
 
 	// register callback to notify client of progress
-	cbStore := beacon.NewCallbackStore(bp.log, ss)
-	defer cbStore.Close()
-
 	cb, done := bp.sendProgressCallback(ctx, stream, req.GetUpTo(), info, bp.opts.clock)
 
 	addr := net.RemoteAddress(stream.Context())
@@ -362,6 +358,7 @@ func (bp *BeaconProcess) StartFollowChain(ctx context.Context, req *drand.StartS
 		Client:      bp.privGateway,
 		Clock:       bp.opts.clock,
 		NodeAddr:    bp.priv.Public.Address(),
+		Sources:     bp.opts.SyncSources(),
 	})
 	if err != nil {
 		return err
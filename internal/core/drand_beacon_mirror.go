@@ -0,0 +1,235 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	"github.com/drand/drand/v2/common"
+	public "github.com/drand/drand/v2/common/chain"
+	"github.com/drand/drand/v2/crypto"
+	"github.com/drand/drand/v2/internal/chain"
+	"github.com/drand/drand/v2/internal/chain/beacon"
+	"github.com/drand/drand/v2/internal/fs"
+)
+
+// mirrorInfoFileName is the file, in the folder of a beacon, where a read-only node keeps the info of the chain it
+// mirrors, to serve it again after a restart
+const mirrorInfoFileName = "mirror_chain_info.json"
+
+// ErrReadOnly is returned by a read-only daemon for the operations it doesn't take part in
+var ErrReadOnly = errors.New("this daemon is read-only, it doesn't take part in DKGs nor in the beacon generation")
+
+// mirror is the chain served by a read-only node. The chain is synced by following it, with `drand sync --follow`,
+// and from the sync sources of the node for as long as it is mirrored. The rounds are verified before being stored,
+// like they are when a node catches up.
+type mirror struct {
+	info    *public.Info
+	dbStore chain.Store
+	store   beacon.CallbackStore
+	// stopSync stops syncing the chain from the sync sources and waits for it, nil when it isn't synced from them
+	stopSync func()
+}
+
+// newFollowStore opens the store of the chain described by info, ready to store the rounds synced from other nodes
+func (bp *BeaconProcess) newFollowStore(ctx context.Context, info *public.Info) (chain.Store, beacon.CallbackStore, error) {
+	store, err := bp.createDBStore(context.Background(), info.GenesisTime, info.Period)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create store: %w", err)
+	}
+
+	// TODO find a better place to put that
+	if err := store.Put(ctx, chain.GenesisBeacon(info.GenesisSeed)); err != nil {
+		store.Close()
+		return nil, nil, fmt.Errorf("unable to insert genesis block: %w", err)
+	}
+
+	// add sch store to handle sch configuration on beacon storing process correctly
+	sch, err := crypto.SchemeFromName(info.GetSchemeName())
+	if err != nil {
+		store.Close()
+		return nil, nil, err
+	}
+	ss, err := beacon.NewSchemeStore(ctx, store, sch)
+	if err != nil {
+		store.Close()
+		return nil, nil, err
+	}
+
+	return store, beacon.NewCallbackStore(bp.log, ss), nil
+}
+
+// mirrorChain returns the mirror of the chain described by info, opening its store and starting to serve it on the
+// first call. The mirror then stays open until the beacon process stops, so that later follows resume it, and its
+// chain info is kept on disk so that it is served again once the node restarts.
+func (bp *BeaconProcess) mirrorChain(ctx context.Context, info *public.Info) (*mirror, error) {
+	bp.state.Lock()
+	if m := bp.mirror; m != nil {
+		bp.state.Unlock()
+		if !bytes.Equal(m.info.Hash(), info.Hash()) {
+			return nil, fmt.Errorf("already mirroring chain %s, can't follow chain %s", m.info.HashString(), info.HashString())
+		}
+		return m, nil
+	}
+
+	if err := bp.saveMirrorInfo(info); err != nil {
+		bp.state.Unlock()
+		return nil, err
+	}
+	dbStore, store, err := bp.newFollowStore(ctx, info)
+	if err != nil {
+		bp.state.Unlock()
+		return nil, err
+	}
+	m := &mirror{info: info, dbStore: dbStore, store: store}
+	bp.mirror = m
+	bp.chainHash = info.Hash()
+	bp.state.Unlock()
+
+	bp.log.Infow("read-only daemon serving the followed chain", "chain_hash", info.HashString())
+	if bp.opts.mirrorCallback != nil {
+		bp.opts.mirrorCallback(ctx, bp.beaconID, info)
+	}
+	return m, nil
+}
+
+// loadMirror serves again the chain a read-only node mirrored before it restarted, if any
+func (bp *BeaconProcess) loadMirror(ctx context.Context) error {
+	f, err := os.Open(bp.mirrorInfoPath())
+	if errors.Is(err, os.ErrNotExist) {
+		bp.log.Infow(fmt.Sprintf("beacon id [%s]: read-only daemon, follow the chain to serve it.", bp.beaconID))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := public.InfoFromJSON(f)
+	if err != nil {
+		return fmt.Errorf("unable to read the info of the mirrored chain: %w", err)
+	}
+	m, err := bp.mirrorChain(ctx, info)
+	if err != nil {
+		return err
+	}
+	bp.startMirrorSync(m)
+	return nil
+}
+
+func (bp *BeaconProcess) mirrorInfoPath() string {
+	return path.Join(bp.opts.ConfigFolderMB(), common.GetCanonicalBeaconID(bp.beaconID), mirrorInfoFileName)
+}
+
+func (bp *BeaconProcess) saveMirrorInfo(info *public.Info) error {
+	fs.CreateSecureFolder(path.Dir(bp.mirrorInfoPath()))
+	f, err := fs.CreateSecureFile(bp.mirrorInfoPath())
+	if err != nil {
+		return fmt.Errorf("unable to save the info of the mirrored chain: %w", err)
+	}
+	defer f.Close()
+	if err := info.ToJSON(f, nil); err != nil {
+		return fmt.Errorf("unable to save the info of the mirrored chain: %w", err)
+	}
+	return f.Sync()
+}
+
+// startMirrorSync starts syncing the mirror from the sync sources of the node in the background. The mirror is
+// synced by a single syncer at a time, since the rounds of a chained scheme must be stored in order, so the follows
+// stop this sync with stopMirrorSync while they run.
+func (bp *BeaconProcess) startMirrorSync(m *mirror) {
+	if len(bp.opts.SyncSources()) == 0 {
+		bp.log.Infow("no sync source set, the mirrored chain is only synced while it is followed", "chain_hash", m.info.HashString())
+		return
+	}
+
+	bp.state.Lock()
+	defer bp.state.Unlock()
+	if bp.mirror != m || m.stopSync != nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	m.stopSync = func() {
+		cancel()
+		<-done
+	}
+	go func() {
+		defer close(done)
+		bp.syncMirror(ctx, m)
+	}()
+}
+
+// stopMirrorSync stops syncing the mirror from the sync sources of the node, if it was
+func (bp *BeaconProcess) stopMirrorSync(m *mirror) {
+	bp.state.Lock()
+	stop := m.stopSync
+	m.stopSync = nil
+	bp.state.Unlock()
+	if stop != nil {
+		stop()
+	}
+}
+
+// syncMirror keeps the mirror in sync with the chain, from the sync sources of the node, until ctx is done
+func (bp *BeaconProcess) syncMirror(ctx context.Context, m *mirror) {
+	logger := bp.log.Named("SyncMirror")
+	syncer, err := beacon.NewSyncManager(ctx, &beacon.SyncConfig{
+		Log:         logger,
+		Store:       m.store,
+		BoltdbStore: m.dbStore,
+		Info:        m.info,
+		Client:      bp.privGateway,
+		Clock:       bp.opts.clock,
+		NodeAddr:    bp.priv.Public.Address(),
+		Sources:     bp.opts.SyncSources(),
+	})
+	if err != nil {
+		logger.Errorw("unable to sync the mirrored chain", "err", err)
+		return
+	}
+	go syncer.Run()
+	defer syncer.Stop()
+
+	for {
+		// without a round to stop at, the sync follows the chain until the sources fail
+		err := syncer.Sync(ctx, beacon.NewRequestInfo(ctx, 0, nil))
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(m.info.Period):
+			logger.Warnw("lost the sync sources of the mirrored chain, trying again", "err", err)
+		}
+	}
+}
+
+// servedChain returns the store of the chain this node serves, along with its period: the chain it generates or,
+// for a read-only node, the chain it mirrors. The store is nil when no chain is served yet. Callers must hold the
+// state lock.
+func (bp *BeaconProcess) servedChain() (beacon.CallbackStore, time.Duration) {
+	switch {
+	case len(bp.chainHash) == 0:
+		return nil, 0
+	case bp.beacon != nil:
+		return bp.beacon.Store(), bp.group.Period
+	case bp.mirror != nil:
+		return bp.mirror.store, bp.mirror.info.Period
+	default:
+		return nil, 0
+	}
+}
+
+// servedScheme returns the scheme of the chain the beacon serves, be it its own or the one it mirrors
+func (bp *BeaconProcess) servedScheme() (*crypto.Scheme, error) {
+	if bp.beacon == nil && bp.mirror != nil {
+		return crypto.SchemeFromName(bp.mirror.info.Scheme)
+	}
+	if bp.group == nil {
+		return nil, ErrNoGroupSetup
+	}
+	return bp.group.Scheme, nil
+}
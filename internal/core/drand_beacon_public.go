@@ -14,7 +14,9 @@ import (
 	chain2 "github.com/drand/drand/v2/common/chain"
 	"github.com/drand/drand/v2/common/tracer"
 	"github.com/drand/drand/v2/crypto"
+	"github.com/drand/drand/v2/internal/chain"
 	"github.com/drand/drand/v2/internal/chain/beacon"
+	chainerrors "github.com/drand/drand/v2/internal/chain/errors"
 	"github.com/drand/drand/v2/internal/net"
 	"github.com/drand/drand/v2/protobuf/drand"
 )
@@ -25,6 +27,11 @@ func (bp *BeaconProcess) PartialBeacon(ctx context.Context, in *drand.PartialBea
 	ctx, span := tracer.NewSpan(ctx, "bp.PartialBeacon")
 	defer span.End()
 
+	if bp.opts.readOnly {
+		span.RecordError(ErrReadOnly)
+		return nil, ErrReadOnly
+	}
+
 	bp.state.RLock()
 	// we need to defer unlock here to avoid races during the partial processing
 	defer bp.state.RUnlock()
@@ -51,10 +58,11 @@ func (bp *BeaconProcess) PublicRand(ctx context.Context, in *drand.PublicRandReq
 	bp.state.RLock()
 	defer bp.state.RUnlock()
 
-	if bp.beacon == nil || len(bp.chainHash) == 0 {
+	store, period := bp.servedChain()
+	if store == nil {
 		return nil, errors.New("drand: beacon generation not started yet")
 	}
-	beaconResp, err := bp.beacon.Store().Last(ctx)
+	beaconResp, err := store.Last(ctx)
 	if wanted := in.GetRound(); err == nil && wanted == beaconResp.GetRound()+1 {
 		// we got a request for the next round about to be produced, let's honor it with a callback
 		// to make sure we don't miss it because of the aggregation time
@@ -76,7 +84,7 @@ func (bp *BeaconProcess) PublicRand(ctx context.Context, in *drand.PublicRandReq
 				return
 			}
 			// we can remove our callback as soon as it's executing once
-			bp.beacon.Store().RemoveCallback(cbID)
+			store.RemoveCallback(cbID)
 
 			if b.GetRound() == wanted {
 				waitlist <- b
@@ -85,11 +93,11 @@ func (bp *BeaconProcess) PublicRand(ctx context.Context, in *drand.PublicRandReq
 			close(waitlist)
 			cancel()
 		}
-		bp.beacon.Store().AddCallback(cbID, fn)
+		store.AddCallback(cbID, fn)
 		select {
 		case <-ctx.Done():
 			// make sure to remove callback, noop if already removed
-			bp.beacon.Store().RemoveCallback(cbID)
+			store.RemoveCallback(cbID)
 			return nil, fmt.Errorf("ctx Done in PublicRand waiting for next beacon: %w", ctx.Err())
 		case b, ok := <-waitlist:
 			if ok {
@@ -97,16 +105,16 @@ func (bp *BeaconProcess) PublicRand(ctx context.Context, in *drand.PublicRandReq
 			} else {
 				return nil, fmt.Errorf("failed to wait for next beacon %d", wanted)
 			}
-		case <-time.After(bp.group.Period + time.Second):
+		case <-time.After(period + time.Second):
 			// we cancel after period+1s since we should never wait so long anyway
 			cancel()
-			bp.beacon.Store().RemoveCallback(cbID)
+			store.RemoveCallback(cbID)
 			return nil, fmt.Errorf("waited too long for next beacon %d", wanted)
 		}
 	} else if wanted > 0 {
 		// fetch the correct entry or the next one if not found
 		// we overwrite beaconResp and err with the correct one
-		beaconResp, err = bp.beacon.Store().Get(ctx, wanted)
+		beaconResp, err = store.Get(ctx, wanted)
 	}
 	if err != nil || beaconResp == nil {
 		bp.log.Debugw("", "public_rand", "unstored_beacon", "round", in.GetRound(), "from", addr)
@@ -120,6 +128,51 @@ func (bp *BeaconProcess) PublicRand(ctx context.Context, in *drand.PublicRandReq
 	return response, nil
 }
 
+// rangeBatchSize is the number of beacons read from the store at once by BeaconRange
+const rangeBatchSize = 1000
+
+// BeaconRange calls fn on each stored beacon from round `from` to `to` included, in order, stopping at the
+// first error or at the first round missing from the store.
+// Beacons are read in fixed size batches, so that memory usage doesn't depend on the size of the range and
+// the store isn't kept busy while fn waits on a slow consumer.
+func (bp *BeaconProcess) BeaconRange(ctx context.Context, from, to uint64, fn func(*common.Beacon) error) error {
+	ctx, span := tracer.NewSpan(ctx, "bp.BeaconRange")
+	defer span.End()
+
+	bp.state.RLock()
+	store, _ := bp.servedChain()
+	bp.state.RUnlock()
+	if store == nil {
+		return errors.New("drand: beacon generation not started yet")
+	}
+
+	batch := make([]*common.Beacon, 0, rangeBatchSize)
+	for next := from; next <= to; {
+		batch = batch[:0]
+		err := store.Cursor(ctx, func(ctx context.Context, c chain.Cursor) error {
+			b, err := c.Seek(ctx, next)
+			for ; err == nil && b != nil && b.Round <= to && len(batch) < rangeBatchSize; b, err = c.Next(ctx) {
+				batch = append(batch, b)
+			}
+			return err
+		})
+		if err != nil && !errors.Is(err, chainerrors.ErrNoBeaconStored) {
+			return err
+		}
+		if len(batch) == 0 {
+			return nil
+		}
+
+		for _, b := range batch {
+			if err := fn(b); err != nil {
+				return err
+			}
+		}
+		next = batch[len(batch)-1].Round + 1
+	}
+	return nil
+}
+
 // a proxy type so public streaming request can use the same logic as in private
 // / protocol syncing request, even though the types differ, so it prevents
 // changing the protobuf structs.
@@ -133,6 +186,7 @@ func (p *proxyRequest) GetFromRound() uint64 {
 
 type proxyStream struct {
 	drand.Public_PublicRandStreamServer
+	sch *crypto.Scheme
 }
 
 func (p *proxyStream) Send(b *drand.BeaconPacket) error {
@@ -140,7 +194,7 @@ func (p *proxyStream) Send(b *drand.BeaconPacket) error {
 		Round:             b.Round,
 		Signature:         b.Signature,
 		PreviousSignature: b.PreviousSignature,
-		Randomness:        crypto.RandomnessFromSignature(b.Signature),
+		Randomness:        p.sch.RandomnessFromSignature(b.Signature),
 		Metadata:          b.Metadata,
 	})
 }
@@ -148,19 +202,22 @@ func (p *proxyStream) Send(b *drand.BeaconPacket) error {
 // PublicRandStream exports a stream of new beacons as they are generated over gRPC
 func (bp *BeaconProcess) PublicRandStream(req *drand.PublicRandRequest, stream drand.Public_PublicRandStreamServer) error {
 	bp.state.RLock()
-	if bp.beacon == nil || len(bp.chainHash) == 0 {
-		bp.state.RUnlock()
+	store, _ := bp.servedChain()
+	sch, err := bp.servedScheme()
+	bp.state.RUnlock()
+	if store == nil {
 		return errors.New("beacon has not started on this node yet")
 	}
-	bp.state.RUnlock()
+	if err != nil {
+		return err
+	}
 
-	store := bp.beacon.Store()
 	proxyReq := &proxyRequest{
 		req,
 	}
 	// make sure we have the correct metadata
 	proxyReq.Metadata = bp.newMetadata()
-	proxyStr := &proxyStream{stream}
+	proxyStr := &proxyStream{Public_PublicRandStreamServer: stream, sch: sch}
 	return beacon.SyncChain(bp.log.Named("PublicRandStream"), store, proxyReq, proxyStr)
 }
 
@@ -172,7 +229,11 @@ func (bp *BeaconProcess) ChainInfo(ctx context.Context, _ *drand.ChainInfoReques
 	bp.state.RLock()
 	group := bp.group
 	chainHash := bp.chainHash
+	mirror := bp.mirror
 	bp.state.RUnlock()
+	if mirror != nil && group == nil {
+		return mirror.info.ToProto(bp.newMetadata()), nil
+	}
 	if group == nil || len(chainHash) == 0 {
 		return nil, ErrNoGroupSetup
 	}
@@ -187,14 +248,12 @@ func (bp *BeaconProcess) ChainInfo(ctx context.Context, _ *drand.ChainInfoReques
 func (bp *BeaconProcess) SyncChain(req *drand.SyncRequest, stream drand.Protocol_SyncChainServer) error {
 	bp.state.RLock()
 	logger := bp.log.Named("SyncChain")
-	b := bp.beacon
-	c := bp.chainHash
-	if b == nil || len(c) == 0 {
+	store, _ := bp.servedChain()
+	if store == nil {
 		logger.Errorw("Received a SyncRequest, but no beacon handler is set yet", "request", req)
 		bp.state.RUnlock()
 		return fmt.Errorf("no beacon handler available")
 	}
-	store := b.Store()
 	// we cannot just defer Unlock because beacon.SyncChain can run for a long time
 	bp.state.RUnlock()
 
@@ -17,6 +17,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/drand/drand/v2/common"
+	public "github.com/drand/drand/v2/common/chain"
 	"github.com/drand/drand/v2/common/testlogger"
 	"github.com/drand/drand/v2/crypto"
 	"github.com/drand/drand/v2/internal/chain"
@@ -109,7 +110,7 @@ func TestBeaconProcess_Stop_MultiBeaconOneBeaconAlreadyStopped(t *testing.T) {
 
 	time.Sleep(250 * time.Millisecond)
 
-	dd.Stop(ctx)
+	require.NoError(t, dd.Stop(ctx))
 	closed, ok = <-dd.WaitExit()
 	require.True(t, ok)
 	require.True(t, closed)
@@ -219,6 +220,52 @@ func TestMemDBBeaconJoinsNetworkAfterDKG(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestFollowStoreAppliesMemDBRetention(t *testing.T) {
+	l := testlogger.New(t)
+	ctx := context.Background()
+	sch, err := crypto.GetSchemeFromEnv()
+	require.NoError(t, err)
+	privs, _ := test.BatchIdentities(t, 1, sch, t.Name())
+
+	confOptions := []ConfigOption{
+		WithConfigFolder(t.TempDir()),
+		WithPrivateListenAddress("127.0.0.1:0"),
+		WithControlPort(test.FreePort()),
+		WithDBStorageEngine(chain.MemDB),
+		WithMemDBSize(2000),
+		WithMemDBRetention(10 * time.Second),
+	}
+	dd, err := NewDrandDaemon(ctx, NewConfig(l, confOptions...))
+	require.NoError(t, err)
+
+	keyStore := test.NewKeyStore()
+	require.NoError(t, keyStore.SaveKeyPair(privs[0]))
+	proc, err := dd.InstantiateBeaconProcess(ctx, t.Name(), keyStore)
+	require.NoError(t, err)
+	// a node following a chain has no group, the retention is computed from the chain info
+	require.Nil(t, proc.group)
+
+	const rounds = 1000
+	info := &public.Info{
+		PublicKey:   privs[0].Public.Key,
+		GenesisTime: time.Now().Unix() - rounds,
+		Period:      time.Second,
+		GenesisSeed: []byte("genesis seed"),
+		Scheme:      sch.Name,
+	}
+	store, _, err := proc.newFollowStore(ctx, info)
+	require.NoError(t, err)
+	defer store.Close()
+
+	for round := uint64(1); round <= rounds; round++ {
+		require.NoError(t, store.Put(ctx, &common.Beacon{Round: round, Signature: []byte{byte(round)}}))
+	}
+	// only the rounds of the last 10 seconds are kept
+	n, err := store.Len(ctx)
+	require.NoError(t, err)
+	require.LessOrEqual(t, n, 15)
+}
+
 func TestMigrateMissingDKGDatabase(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping slow test in short mode.")
@@ -5,6 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
+	gonet "net"
+	"path/filepath"
+	"sort"
 	"sync"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -17,6 +20,7 @@ import (
 	"github.com/drand/drand/v2/common/log"
 	"github.com/drand/drand/v2/common/tracer"
 	dhttp "github.com/drand/drand/v2/handler/http"
+	"github.com/drand/drand/v2/internal/chain"
 	"github.com/drand/drand/v2/internal/dkg"
 	"github.com/drand/drand/v2/internal/metrics"
 	"github.com/drand/drand/v2/internal/metrics/pprof"
@@ -32,6 +36,7 @@ type DrandDaemon struct {
 
 	privGateway *net.PrivateGateway
 	pubGateway  *net.PublicGateway
+	grpcWeb     *net.GRPCWebHandler
 	control     net.ControlListener
 
 	dkg DKGProcess
@@ -46,16 +51,21 @@ type DrandDaemon struct {
 	completedDKGs *util.FanOutChan[dkg.SharingOutput]
 	exitCh        chan bool
 
+	// the public randomness streams, drained on stop
+	streams *streamTracker
+
 	// version indicates the base code variant
 	version common.Version
 }
 
 type DKGProcess interface {
 	DKGStatus(context context.Context, request *pdkg.DKGStatusRequest) (*pdkg.DKGStatusResponse, error)
+	DKGState(context context.Context, request *pdkg.DKGStatusRequest) (*pdkg.DKGStateResponse, error)
 	Command(context context.Context, command *pdkg.DKGCommand) (*pdkg.EmptyDKGResponse, error)
 	Packet(context context.Context, packet *pdkg.GossipPacket) (*pdkg.EmptyDKGResponse, error)
 	Migrate(beaconID string, group *key.Group, share *key.Share) error
 	BroadcastDKG(context context.Context, packet *pdkg.DKGPacket) (*pdkg.EmptyDKGResponse, error)
+	ResumeExecution(context context.Context, beaconID string) error
 	Close()
 }
 
@@ -70,6 +80,7 @@ func NewDrandDaemon(ctx context.Context, c *Config) (*DrandDaemon, error) {
 		opts:            c,
 		log:             logger,
 		exitCh:          make(chan bool, 1),
+		streams:         newStreamTracker(),
 		completedDKGs:   util.NewFanOutChan[dkg.SharingOutput](),
 		version:         common.GetAppVersion(),
 		beaconProcesses: make(map[string]*BeaconProcess),
@@ -89,6 +100,17 @@ func NewDrandDaemon(ctx context.Context, c *Config) (*DrandDaemon, error) {
 		}
 	}
 
+	// Register the handler for the http server of the chains followed by a read-only daemon
+	c.mirrorCallback = func(_ context.Context, beaconID string, info *chain2.Info) {
+		drandDaemon.state.RLock()
+		bp, isPresent := drandDaemon.beaconProcesses[beaconID]
+		drandDaemon.state.RUnlock()
+
+		if isPresent {
+			drandDaemon.registerBeaconHandler(beaconID, info.HashString(), bp)
+		}
+	}
+
 	if err := drandDaemon.init(ctx); err != nil {
 		return nil, err
 	}
@@ -129,6 +151,19 @@ func (dd *DrandDaemon) init(ctx context.Context) error {
 	if privAddr == "" {
 		return fmt.Errorf("private listen address cannot be empty")
 	}
+	if advertiseAddr := c.AdvertiseAddress(""); advertiseAddr != "" {
+		if _, _, err := gonet.SplitHostPort(advertiseAddr); err != nil {
+			return fmt.Errorf("invalid advertise address %q: %w", advertiseAddr, err)
+		}
+	}
+	for _, source := range c.syncSources {
+		if _, _, err := gonet.SplitHostPort(source); err != nil {
+			return fmt.Errorf("invalid sync source %q: %w", source, err)
+		}
+	}
+	if c.grpcWeb && pubAddr == "" {
+		return fmt.Errorf("gRPC-Web requires a public listen address")
+	}
 
 	// we set our logger name to its node address
 	dd.log = dd.log.Named(privAddr)
@@ -144,9 +179,22 @@ func (dd *DrandDaemon) init(ctx context.Context) error {
 		span.RecordError(err)
 		return err
 	}
+	handler.SetFreshnessHeaders(c.freshnessHeaders)
+	handler.SetRateLimit(c.rateLimit, c.rateBurst, c.rateLimitTrustProxy)
+	if c.httpRangeMaxRounds > 0 {
+		handler.SetRangeMaxRounds(c.httpRangeMaxRounds)
+	}
 
 	if pubAddr != "" {
-		if dd.pubGateway, err = net.NewRESTPublicGateway(ctx, pubAddr, handler.GetHTTPHandler()); err != nil {
+		httpHandler := handler.GetHTTPHandler()
+		if c.grpcWeb {
+			if dd.grpcWeb, err = net.NewGRPCWebHandler(ctx, dd, httpHandler); err != nil {
+				span.RecordError(err)
+				return err
+			}
+			httpHandler = dd.grpcWeb
+		}
+		if dd.pubGateway, err = net.NewRESTPublicGateway(ctx, pubAddr, httpHandler); err != nil {
 			span.RecordError(err)
 			return err
 		}
@@ -173,9 +221,11 @@ func (dd *DrandDaemon) init(ctx context.Context) error {
 	}
 
 	dkgConfig := dkg.Config{
-		TimeBetweenDKGPhases: c.dkgPhaseTimeout,
-		KickoffGracePeriod:   c.dkgKickoffGracePeriod,
-		SkipKeyVerification:  false,
+		TimeBetweenDKGPhases:   c.dkgPhaseTimeout,
+		KickoffGracePeriod:     c.dkgKickoffGracePeriod,
+		SkipKeyVerification:    false,
+		BroadcastRetries:       c.dkgBroadcastRetries,
+		BroadcastRetryInterval: c.dkgRetryInterval,
 	}
 	dd.dkg = dkg.NewDKGProcess(dkgStore,
 		dd,
@@ -237,6 +287,8 @@ func (dd *DrandDaemon) RemoveBeaconProcess(ctx context.Context, beaconID string,
 	if bp.group != nil {
 		info := chain2.NewChainInfo(bp.group)
 		chainHash = info.HashString()
+	} else if bp.mirror != nil {
+		chainHash = bp.mirror.info.HashString()
 	}
 
 	dd.state.Lock()
@@ -261,9 +313,11 @@ func (dd *DrandDaemon) AddBeaconHandler(ctx context.Context, beaconID string, bp
 	_, span := tracer.NewSpan(ctx, "dd.AddBeaconHandler")
 	defer span.End()
 
-	chainHash := chain2.NewChainInfo(bp.group).HashString()
+	dd.registerBeaconHandler(beaconID, chain2.NewChainInfo(bp.group).HashString(), bp)
+}
 
-	bh := dd.handler.RegisterNewBeaconHandler(&drandProxy{bp}, chainHash)
+func (dd *DrandDaemon) registerBeaconHandler(beaconID, chainHash string, bp *BeaconProcess) {
+	bh := dd.handler.RegisterNewBeaconHandler(&drandProxy{r: bp}, chainHash)
 
 	dd.state.Lock()
 	dd.chainHashes[chainHash] = beaconID
@@ -284,11 +338,16 @@ func (dd *DrandDaemon) RemoveBeaconHandler(ctx context.Context, beaconID string,
 	_, span := tracer.NewSpan(ctx, "dd.RemoveBeaconHandler")
 	defer span.End()
 
-	if bp.group == nil {
+	var info *chain2.Info
+	switch {
+	case bp.group != nil:
+		info = chain2.NewChainInfo(bp.group)
+	case bp.mirror != nil:
+		info = bp.mirror.info
+	default:
 		return
 	}
 
-	info := chain2.NewChainInfo(bp.group)
 	dd.handler.RemoveBeaconHandler(info.HashString())
 	if common.IsDefaultBeaconID(beaconID) {
 		dd.handler.RemoveBeaconHandler(common.DefaultChainHash)
@@ -319,6 +378,14 @@ func (dd *DrandDaemon) LoadBeaconsFromDisk(ctx context.Context, metricsFlag stri
 		span.RecordError(err)
 		return err
 	}
+	beaconIDs := make([]string, 0, len(stores))
+	for beaconID := range stores {
+		beaconIDs = append(beaconIDs, beaconID)
+	}
+	if err := checkStoresIsolation(dd.opts, beaconIDs); err != nil {
+		span.RecordError(err)
+		return err
+	}
 
 	startedAtLeastOne := false
 	for beaconID, fileStore := range stores {
@@ -339,11 +406,54 @@ func (dd *DrandDaemon) LoadBeaconsFromDisk(ctx context.Context, metricsFlag stri
 	}
 
 	// Start metrics server
-	_ = metrics.Start(dd.log, metricsFlag, pprof.WithProfile(), dd.privGateway.MetricsClient)
+	_ = metrics.Start(dd.log, metricsFlag, pprof.WithProfile(), dd.privGateway.MetricsClient, dd.opts.MetricsServerOptions())
 
 	return nil
 }
 
+// checkStoresIsolation returns an error if two of the given beacons would share the same bolt database, e.g. since
+// the database folder of one of them is a symbolic link to the one of another: they would corrupt each other's chain.
+func checkStoresIsolation(conf *Config, beaconIDs []string) error {
+	if conf.dbStorageEngine != chain.BoltDB {
+		return nil
+	}
+	sort.Strings(beaconIDs)
+	owners := make(map[string]string, len(beaconIDs))
+	for _, beaconID := range beaconIDs {
+		dbPath, err := resolvePath(conf.DBFolder(beaconID))
+		if err != nil {
+			return fmt.Errorf("unable to check the database folder of beacon %q: %w", beaconID, err)
+		}
+		if other, ok := owners[dbPath]; ok {
+			return fmt.Errorf("beacons %q and %q share the database in %s, each beacon needs its own database folder",
+				other, beaconID, dbPath)
+		}
+		owners[dbPath] = beaconID
+	}
+	return nil
+}
+
+// resolvePath resolves the symbolic links of p, which may not exist yet, in which case its closest existing parent
+// folder is resolved.
+func resolvePath(p string) (string, error) {
+	resolved, err := filepath.EvalSymlinks(p)
+	if err == nil {
+		return resolved, nil
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return "", err
+	}
+	parent := filepath.Dir(p)
+	if parent == p {
+		return p, nil
+	}
+	resolvedParent, err := resolvePath(parent)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(resolvedParent, filepath.Base(p)), nil
+}
+
 func (dd *DrandDaemon) LoadBeaconFromDisk(ctx context.Context, beaconID string) (*BeaconProcess, error) {
 	ctx, span := tracer.NewSpan(ctx, "dd.LoadBeaconFromDisk")
 	defer span.End()
@@ -370,6 +480,21 @@ func (dd *DrandDaemon) LoadBeaconFromStore(ctx context.Context, beaconID string,
 	}
 	metrics.DKGStateChange(status.Current.BeaconID, status.Current.Epoch, false, status.Current.State)
 
+	if dd.opts.readOnly {
+		if err := bp.loadMirror(ctx); err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+		return bp, nil
+	}
+
+	// we may have been restarted in the middle of a DKG execution, in which case we try to rejoin it
+	if status.Current.State == uint32(dkg.Executing) {
+		if err := dd.dkg.ResumeExecution(ctx, beaconID); err != nil {
+			dd.log.Errorw("could not resume the DKG execution", "beacon id", beaconID, "err", err)
+		}
+	}
+
 	freshRun := status.Complete == nil
 	if freshRun {
 		// migration path from v1-> v2
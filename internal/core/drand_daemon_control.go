@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/drand/drand/v2/common"
@@ -79,7 +80,9 @@ func (dd *DrandDaemon) Shutdown(ctx context.Context, in *drand.ShutdownRequest)
 
 	// If beacon id is empty, we will stop the entire node. Otherwise, we will stop the specific beacon process
 	if in.GetMetadata().GetBeaconID() == "" {
-		dd.Stop(ctx)
+		if err := dd.Stop(ctx); err != nil {
+			return nil, err
+		}
 	} else {
 		beaconID, err := dd.readBeaconID(in.GetMetadata())
 		if err != nil {
@@ -94,9 +97,25 @@ func (dd *DrandDaemon) Shutdown(ctx context.Context, in *drand.ShutdownRequest)
 		dd.RemoveBeaconHandler(ctx, beaconID, bp)
 
 		bp.Stop(ctx)
-		<-bp.WaitExit()
+		stopped := true
+		if timeout, ok := stopTimeout(ctx); ok {
+			t := time.NewTimer(timeout)
+			select {
+			case <-bp.WaitExit():
+				t.Stop()
+			case <-t.C:
+				stopped = false
+			}
+		} else {
+			<-bp.WaitExit()
+		}
 
 		dd.RemoveBeaconProcess(ctx, beaconID, bp)
+		if !stopped {
+			err := fmt.Errorf("beacon process %q failed to stop in time", beaconID)
+			span.RecordError(err)
+			return nil, err
+		}
 	}
 
 	metadata := drand.NewMetadata(dd.version.ToProto())
@@ -104,6 +123,20 @@ func (dd *DrandDaemon) Shutdown(ctx context.Context, in *drand.ShutdownRequest)
 	return &drand.ShutdownResponse{Metadata: metadata}, nil
 }
 
+// stopTimeout returns how long we can wait for subsystems to stop, given the deadline set by the caller on the
+// context. We keep a small margin to be able to report back to the caller before its deadline elapses.
+func stopTimeout(ctx context.Context) (time.Duration, bool) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return 0, false
+	}
+	timeout := time.Until(deadline) - stopReplyMargin
+	if timeout < 0 {
+		timeout = 0
+	}
+	return timeout, true
+}
+
 // LoadBeacon tells the DrandDaemon to load a new beacon into the memory
 func (dd *DrandDaemon) LoadBeacon(ctx context.Context, in *drand.LoadBeaconRequest) (*drand.LoadBeaconResponse, error) {
 	ctx, span := tracer.NewSpan(ctx, "dd.LoadBeacon")
@@ -197,7 +230,9 @@ func (dd *DrandDaemon) ListBeaconIDs(ctx context.Context, _ *drand.ListBeaconIDs
 }
 
 func (dd *DrandDaemon) KeypairFor(beaconID string) (*key.Pair, error) {
+	dd.state.RLock()
 	bp, exists := dd.beaconProcesses[beaconID]
+	dd.state.RUnlock()
 	if !exists {
 		return nil, fmt.Errorf("no beacon found for ID %s", beaconID)
 	}
@@ -206,7 +241,7 @@ func (dd *DrandDaemon) KeypairFor(beaconID string) (*key.Pair, error) {
 }
 
 // Stop simply stops all drand operations.
-func (dd *DrandDaemon) Stop(ctx context.Context) {
+func (dd *DrandDaemon) Stop(ctx context.Context) error {
 	ctx, span := tracer.NewSpan(ctx, "dd.Stop")
 	defer span.End()
 
@@ -216,11 +251,47 @@ func (dd *DrandDaemon) Stop(ctx context.Context) {
 		msg := "trying to stop an already stopping daemon"
 		dd.log.Errorw(msg)
 		span.RecordError(errors.New(msg))
-		return
+		return nil
 	default:
 		dd.log.Infow("Stopping DrandDaemon")
 	}
 
+	// By default, we give each beacon process 5 seconds to terminate, unless the caller set a deadline.
+	//nolint:mnd // We want to wait for 5 seconds before sending a timeout for the beacon shutdown
+	timeout := 5 * time.Second
+	bounded, hasDeadline := stopTimeout(ctx)
+	if hasDeadline {
+		timeout = bounded
+	}
+	// the timer is shared by all the subsystems when the caller set a deadline.
+	var deadline <-chan time.Time
+	if hasDeadline {
+		deadline = time.After(timeout)
+	}
+	waitFor := func(done <-chan bool) bool {
+		wait := deadline
+		if wait == nil {
+			wait = time.After(timeout)
+		}
+		select {
+		case <-done:
+			return true
+		case <-wait:
+			return false
+		}
+	}
+
+	var failed []string
+
+	// the streams are drained first, while the beacon processes still serve them
+	grace := dd.opts.StreamDrainPeriod()
+	if hasDeadline {
+		grace = min(grace, timeout)
+	}
+	if !dd.streams.drain(grace) {
+		dd.log.Warnw("public streams didn't end in time, they will be closed with the gateways", "grace", grace)
+	}
+
 	dd.dkg.Close()
 
 	for _, bp := range dd.beaconProcesses {
@@ -231,29 +302,35 @@ func (dd *DrandDaemon) Stop(ctx context.Context) {
 	for _, bp := range dd.beaconProcesses {
 		dd.log.Debugw("waiting for beaconProcess to finish", "id", bp.getBeaconID())
 
-		//nolint:mnd // We want to wait for 5 seconds before sending a timeout for the beacon shutdown
-		t := time.NewTimer(5 * time.Second)
-		select {
-		case <-bp.WaitExit():
-			if !t.Stop() {
-				<-t.C
-			}
-		case <-t.C:
-			dd.log.Errorw("beacon process failed to terminate in 5 seconds, exiting forcefully", "id", bp.getBeaconID())
-			err := fmt.Errorf("beacon process %q failed to terminate in 5 seconds, exiting forcefully", bp.getBeaconID())
+		if !waitFor(bp.WaitExit()) {
+			dd.log.Errorw("beacon process failed to terminate in time, exiting forcefully", "id", bp.getBeaconID(), "timeout", timeout)
+			err := fmt.Errorf("beacon process %q failed to terminate in %s, exiting forcefully", bp.getBeaconID(), timeout)
 			span.RecordError(err)
+			failed = append(failed, fmt.Sprintf("beacon process %q", bp.getBeaconID()))
 		}
 	}
 
-	dd.log.Debugw("all beacon processes exited successfully")
+	dd.log.Debugw("all beacon processes exited")
 
 	if dd.pubGateway != nil {
-		dd.pubGateway.StopAll(ctx)
-		dd.log.Debugw("pubGateway stopped successfully")
+		if !waitFor(runStop(func() { dd.pubGateway.StopAll(ctx) })) {
+			dd.log.Errorw("pubGateway failed to stop in time")
+			failed = append(failed, "public gateway")
+		} else {
+			dd.log.Debugw("pubGateway stopped successfully")
+		}
+	}
+
+	if dd.grpcWeb != nil {
+		dd.grpcWeb.Stop()
 	}
 
-	dd.privGateway.StopAll(ctx)
-	dd.log.Debugw("privGateway stopped successfully")
+	if !waitFor(runStop(func() { dd.privGateway.StopAll(ctx) })) {
+		dd.log.Errorw("privGateway failed to stop in time")
+		failed = append(failed, "private gateway")
+	} else {
+		dd.log.Debugw("privGateway stopped successfully")
+	}
 
 	// We launch this in a goroutine to allow the stop connection to exit successfully.
 	// If we wouldn't launch it in a goroutine the Stop call itself would block the shutdown
@@ -275,6 +352,23 @@ func (dd *DrandDaemon) Stop(ctx context.Context) {
 		dd.log.Warnw("Context canceled, DrandDaemon exitCh probably blocked")
 		close(dd.exitCh)
 	}
+
+	if len(failed) > 0 {
+		err := fmt.Errorf("failed to stop in time: %s", strings.Join(failed, ", "))
+		span.RecordError(err)
+		return err
+	}
+	return nil
+}
+
+// runStop runs the given stop function in the background and returns a channel closed once it returns.
+func runStop(stop func()) <-chan bool {
+	done := make(chan bool)
+	go func() {
+		stop()
+		close(done)
+	}()
+	return done
 }
 
 // WaitExit returns a channel that signals when drand stops its operations
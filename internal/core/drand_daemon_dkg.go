@@ -0,0 +1,68 @@
+package core
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/BurntSushi/toml"
+
+	"github.com/drand/drand/v2/common/key"
+	drand "github.com/drand/drand/v2/protobuf/dkg"
+)
+
+// The methods below let embedders drive a DKG from Go, the same way `drand dkg` does over the control port.
+// They go through the DKG state machine, which serialises the commands, so they can be called concurrently. The
+// errors of the state machine are wrapped, and can be checked with errors.Is, e.g. against dkg.ErrThresholdTooLow.
+
+// ProposeDKG proposes the initial DKG of the given beacon, led by this node, to the joiners of the proposal
+func (dd *DrandDaemon) ProposeDKG(ctx context.Context, beaconID string, proposal *drand.FirstProposalOptions) error {
+	return dd.runDKGCommand(ctx, beaconID, &drand.DKGCommand{Command: &drand.DKGCommand_Initial{Initial: proposal}})
+}
+
+// ProposeReshare proposes a resharing of the given beacon, led by this node
+func (dd *DrandDaemon) ProposeReshare(ctx context.Context, beaconID string, proposal *drand.ProposalOptions) error {
+	return dd.runDKGCommand(ctx, beaconID, &drand.DKGCommand{Command: &drand.DKGCommand_Resharing{Resharing: proposal}})
+}
+
+// JoinDKG joins the DKG proposed for the given beacon. The previous group is only required when joining a
+// resharing, and must be nil when joining the initial DKG.
+func (dd *DrandDaemon) JoinDKG(ctx context.Context, beaconID string, previousGroup *key.Group) error {
+	var groupFile []byte
+	if previousGroup != nil {
+		var buf bytes.Buffer
+		if err := toml.NewEncoder(&buf).Encode(previousGroup.TOML()); err != nil {
+			return err
+		}
+		groupFile = buf.Bytes()
+	}
+	return dd.runDKGCommand(ctx, beaconID, &drand.DKGCommand{Command: &drand.DKGCommand_Join{
+		Join: &drand.JoinOptions{GroupFile: groupFile},
+	}})
+}
+
+// AcceptDKG accepts the resharing proposed for the given beacon
+func (dd *DrandDaemon) AcceptDKG(ctx context.Context, beaconID string) error {
+	return dd.runDKGCommand(ctx, beaconID, &drand.DKGCommand{Command: &drand.DKGCommand_Accept{Accept: &drand.AcceptOptions{}}})
+}
+
+// RejectDKG rejects the resharing proposed for the given beacon
+func (dd *DrandDaemon) RejectDKG(ctx context.Context, beaconID string) error {
+	return dd.runDKGCommand(ctx, beaconID, &drand.DKGCommand{Command: &drand.DKGCommand_Reject{Reject: &drand.RejectOptions{}}})
+}
+
+// ExecuteDKG starts the execution of the DKG proposed by this node for the given beacon, once the other nodes have
+// joined or accepted it. It returns once the execution is kicked off, DKGStatus reports when it completes.
+func (dd *DrandDaemon) ExecuteDKG(ctx context.Context, beaconID string) error {
+	return dd.runDKGCommand(ctx, beaconID, &drand.DKGCommand{Command: &drand.DKGCommand_Execute{Execute: &drand.ExecutionOptions{}}})
+}
+
+// AbortDKG aborts the DKG in progress for the given beacon
+func (dd *DrandDaemon) AbortDKG(ctx context.Context, beaconID string) error {
+	return dd.runDKGCommand(ctx, beaconID, &drand.DKGCommand{Command: &drand.DKGCommand_Abort{Abort: &drand.AbortOptions{}}})
+}
+
+func (dd *DrandDaemon) runDKGCommand(ctx context.Context, beaconID string, command *drand.DKGCommand) error {
+	command.Metadata = &drand.CommandMetadata{BeaconID: beaconID}
+	_, err := dd.Command(ctx, command)
+	return err
+}
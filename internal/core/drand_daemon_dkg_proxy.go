@@ -18,7 +18,20 @@ func (dd *DrandDaemon) DKGStatus(ctx context.Context, request *drand.DKGStatusRe
 	return dd.dkg.DKGStatus(ctx, request)
 }
 
+func (dd *DrandDaemon) DKGState(ctx context.Context, request *drand.DKGStatusRequest) (*drand.DKGStateResponse, error) {
+	beaconID := request.BeaconID
+
+	if !dd.beaconExists(beaconID) {
+		return nil, fmt.Errorf("beacon with ID %s is not running on this daemon", beaconID)
+	}
+
+	return dd.dkg.DKGState(ctx, request)
+}
+
 func (dd *DrandDaemon) Command(ctx context.Context, command *drand.DKGCommand) (*drand.EmptyDKGResponse, error) {
+	if dd.opts.readOnly {
+		return nil, ErrReadOnly
+	}
 	if command.Metadata == nil {
 		return nil, errors.New("could not find command metadata to read beaconID")
 	}
@@ -32,6 +45,9 @@ func (dd *DrandDaemon) Command(ctx context.Context, command *drand.DKGCommand) (
 }
 
 func (dd *DrandDaemon) Packet(ctx context.Context, packet *drand.GossipPacket) (*drand.EmptyDKGResponse, error) {
+	if dd.opts.readOnly {
+		return nil, ErrReadOnly
+	}
 	if packet.Metadata == nil {
 		return nil, errors.New("could not find command metadata to read beaconID")
 	}
@@ -45,6 +61,9 @@ func (dd *DrandDaemon) Packet(ctx context.Context, packet *drand.GossipPacket) (
 }
 
 func (dd *DrandDaemon) BroadcastDKG(ctx context.Context, packet *drand.DKGPacket) (*drand.EmptyDKGResponse, error) {
+	if dd.opts.readOnly {
+		return nil, ErrReadOnly
+	}
 	if packet.GetDkg() == nil {
 		return nil, errors.New("DKG was missing from packet")
 	}
@@ -61,6 +80,8 @@ func (dd *DrandDaemon) BroadcastDKG(ctx context.Context, packet *drand.DKGPacket
 }
 
 func (dd *DrandDaemon) beaconExists(beaconID string) bool {
+	dd.state.RLock()
+	defer dd.state.RUnlock()
 	_, exists := dd.beaconProcesses[beaconID]
 	return exists
 }
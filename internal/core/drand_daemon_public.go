@@ -46,7 +46,9 @@ func (dd *DrandDaemon) PublicRandStream(in *drand.PublicRandRequest, stream dran
 		return err
 	}
 
-	return bp.PublicRandStream(in, stream)
+	return dd.streams.serve(stream, func(stream drand.Public_PublicRandStreamServer) error {
+		return bp.PublicRandStream(in, stream)
+	})
 }
 
 // ChainInfo replies with the chain information this node participates to
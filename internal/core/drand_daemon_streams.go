@@ -0,0 +1,94 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/drand/drand/v2/protobuf/drand"
+)
+
+// errShuttingDown is the status the public streams end with when the daemon stops, so that clients can tell a
+// shutdown from a failure and reconnect to another node right away.
+var errShuttingDown = status.Error(codes.Unavailable, "server shutting down")
+
+// streamTracker keeps track of the public randomness streams being served, so that they can be drained when the
+// daemon stops instead of being cut when the gateways are closed.
+type streamTracker struct {
+	sync.Mutex
+	draining bool
+	active   map[*drainableStream]struct{}
+	wg       sync.WaitGroup
+}
+
+func newStreamTracker() *streamTracker {
+	return &streamTracker{active: make(map[*drainableStream]struct{})}
+}
+
+// drainableStream is a stream whose context is canceled when the daemon starts draining
+type drainableStream struct {
+	drand.Public_PublicRandStreamServer
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+}
+
+func (d *drainableStream) Context() context.Context {
+	return d.ctx
+}
+
+// serve runs the handler of a public stream, unless the daemon is draining. A stream ended by the drain returns
+// errShuttingDown rather than the error of the handler.
+func (s *streamTracker) serve(stream drand.Public_PublicRandStreamServer, handler func(drand.Public_PublicRandStreamServer) error) error {
+	s.Lock()
+	if s.draining {
+		s.Unlock()
+		return errShuttingDown
+	}
+	ctx, cancel := context.WithCancelCause(stream.Context())
+	d := &drainableStream{Public_PublicRandStreamServer: stream, ctx: ctx, cancel: cancel}
+	s.active[d] = struct{}{}
+	s.wg.Add(1)
+	s.Unlock()
+
+	defer func() {
+		s.Lock()
+		delete(s.active, d)
+		s.Unlock()
+		cancel(nil)
+		s.wg.Done()
+	}()
+
+	err := handler(d)
+	if errors.Is(context.Cause(ctx), errShuttingDown) {
+		return errShuttingDown
+	}
+	return err
+}
+
+// drain stops accepting new streams, ends the active ones with errShuttingDown and waits up to the grace period for
+// their handlers to return. It reports whether they all did in time, the remaining ones are closed along with the
+// gateways.
+func (s *streamTracker) drain(grace time.Duration) bool {
+	s.Lock()
+	s.draining = true
+	for d := range s.active {
+		d.cancel(errShuttingDown)
+	}
+	s.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-time.After(grace):
+		return false
+	}
+}
@@ -2,16 +2,23 @@ package core
 
 import (
 	"context"
+	"io"
 	"net"
+	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/drand/drand/v2/common/testlogger"
 	"github.com/drand/drand/v2/crypto"
+	"github.com/drand/drand/v2/internal/chain"
 	"github.com/drand/drand/v2/internal/test"
+	"github.com/drand/drand/v2/protobuf/drand"
 )
 
 func TestNoPanicWhenDrandDaemonPortInUse(t *testing.T) {
@@ -69,7 +76,7 @@ func TestDrandDaemon_Stop(t *testing.T) {
 	defer cancel()
 
 	t.Log("running dd.Stop()")
-	dd.Stop(ctx)
+	require.NoError(t, dd.Stop(ctx))
 
 	t.Log("running dd.WaitExit()")
 	closing, ok := <-dd.WaitExit()
@@ -85,3 +92,160 @@ func TestDrandDaemon_Stop(t *testing.T) {
 	require.False(t, ok, "If we block the exit of drandDaemon by waiting for all beacons to exit,"+
 		"then this should return false as we consume the value already")
 }
+
+func TestStopTimeout(t *testing.T) {
+	_, ok := stopTimeout(context.Background())
+	require.False(t, ok, "no deadline means the default stop timeouts apply")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	timeout, ok := stopTimeout(ctx)
+	require.True(t, ok)
+	require.Greater(t, timeout, time.Duration(0))
+	require.LessOrEqual(t, timeout, 3*time.Second-stopReplyMargin)
+
+	ctx, cancel = context.WithTimeout(context.Background(), stopReplyMargin/2)
+	defer cancel()
+	timeout, ok = stopTimeout(ctx)
+	require.True(t, ok)
+	require.Equal(t, time.Duration(0), timeout)
+}
+
+func TestDrandDaemonAdvertiseAddress(t *testing.T) {
+	l := testlogger.New(t)
+	ctx := context.Background()
+	sch, err := crypto.GetSchemeFromEnv()
+	require.NoError(t, err)
+	privs, _ := test.BatchIdentities(t, 1, sch, t.Name())
+	stored := privs[0].Public.Addr
+
+	_, err = NewDrandDaemon(ctx, NewConfig(l,
+		WithConfigFolder(t.TempDir()),
+		WithPrivateListenAddress("127.0.0.1:0"),
+		WithAdvertiseAddress("drand.example.com"),
+		WithControlPort(test.FreePort()),
+	))
+	require.ErrorContains(t, err, "invalid advertise address")
+
+	confOptions := []ConfigOption{
+		WithConfigFolder(t.TempDir()),
+		WithPrivateListenAddress("127.0.0.1:0"),
+		WithAdvertiseAddress("drand.example.com:4444"),
+		WithControlPort(test.FreePort()),
+	}
+	confOptions = append(confOptions, WithTestDB(t, test.ComputeDBName())...)
+
+	dd, err := NewDrandDaemon(ctx, NewConfig(l, confOptions...))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, dd.Stop(ctx)) }()
+
+	store := test.NewKeyStore()
+	require.NoError(t, store.SaveKeyPair(privs[0]))
+	proc, err := dd.InstantiateBeaconProcess(ctx, t.Name(), store)
+	require.NoError(t, err)
+
+	// the advertised address replaces the one of the key pair, whose signature doesn't cover it
+	require.NotEqual(t, stored, "drand.example.com:4444")
+	require.Equal(t, "drand.example.com:4444", proc.priv.Public.Address())
+	require.NoError(t, proc.priv.Public.ValidSignature())
+	resp, err := proc.PublicKey(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, "drand.example.com:4444", resp.Addr)
+}
+
+// fakeRandStream is a public randomness stream that discards what is sent
+type fakeRandStream struct {
+	drand.Public_PublicRandStreamServer
+	ctx context.Context
+}
+
+func (f *fakeRandStream) Context() context.Context {
+	return f.ctx
+}
+
+func TestStreamTrackerDrain(t *testing.T) {
+	tracker := newStreamTracker()
+	stream := &fakeRandStream{ctx: context.Background()}
+
+	// a stream ending on its own keeps its error
+	err := tracker.serve(stream, func(drand.Public_PublicRandStreamServer) error {
+		return io.EOF
+	})
+	require.ErrorIs(t, err, io.EOF)
+
+	started := make(chan struct{})
+	ended := make(chan error, 1)
+	go func() {
+		ended <- tracker.serve(stream, func(s drand.Public_PublicRandStreamServer) error {
+			close(started)
+			<-s.Context().Done()
+			return s.Context().Err()
+		})
+	}()
+	<-started
+
+	require.True(t, tracker.drain(time.Second))
+	err = <-ended
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	require.ErrorContains(t, err, "server shutting down")
+
+	// no stream is accepted once draining
+	err = tracker.serve(stream, func(drand.Public_PublicRandStreamServer) error {
+		require.Fail(t, "the stream shouldn't be served while draining")
+		return nil
+	})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	// a stream ignoring the drain is waited for up to the grace period only
+	tracker = newStreamTracker()
+	release := make(chan struct{})
+	started = make(chan struct{})
+	go func() {
+		ended <- tracker.serve(stream, func(drand.Public_PublicRandStreamServer) error {
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+	<-started
+	require.False(t, tracker.drain(10*time.Millisecond))
+	close(release)
+	require.Equal(t, codes.Unavailable, status.Code(<-ended))
+}
+
+func TestDrandDaemonSyncSources(t *testing.T) {
+	l := testlogger.New(t)
+	ctx := context.Background()
+
+	_, err := NewDrandDaemon(ctx, NewConfig(l,
+		WithConfigFolder(t.TempDir()),
+		WithPrivateListenAddress("127.0.0.1:0"),
+		WithSyncSources([]string{"127.0.0.1:4444", "mirror.example.com"}),
+		WithControlPort(test.FreePort()),
+	))
+	require.ErrorContains(t, err, `invalid sync source "mirror.example.com"`)
+
+	c := NewConfig(l, WithSyncSources([]string{"127.0.0.1:4444", "mirror.example.com:443"}))
+	sources := c.SyncSources()
+	require.Len(t, sources, 2)
+	require.Equal(t, "mirror.example.com:443", sources[1].Address())
+}
+
+func TestCheckStoresIsolation(t *testing.T) {
+	conf := NewConfig(testlogger.New(t), WithConfigFolder(t.TempDir()), WithDBStorageEngine(chain.BoltDB))
+	require.NoError(t, os.MkdirAll(conf.DBFolder("beacon1"), 0o700))
+	require.NoError(t, os.MkdirAll(conf.DBFolder("beacon2"), 0o700))
+
+	// the database of beacon3 isn't created yet
+	require.NoError(t, checkStoresIsolation(conf, []string{"beacon1", "beacon2", "beacon3"}))
+
+	// beacon3 would open the database of beacon1
+	require.NoError(t, os.MkdirAll(filepath.Dir(conf.DBFolder("beacon3")), 0o700))
+	require.NoError(t, os.Symlink(conf.DBFolder("beacon1"), conf.DBFolder("beacon3")))
+	err := checkStoresIsolation(conf, []string{"beacon1", "beacon2", "beacon3"})
+	require.ErrorContains(t, err, `beacons "beacon1" and "beacon3" share the database`)
+
+	// other engines don't store the beacons in folders
+	conf = NewConfig(testlogger.New(t), WithConfigFolder(conf.configFolder), WithDBStorageEngine(chain.MemDB))
+	require.NoError(t, checkStoresIsolation(conf, []string{"beacon1", "beacon3"}))
+}
@@ -3,6 +3,7 @@ package core
 import (
 	"context"
 	"net"
+	"sync"
 	"time"
 
 	"google.golang.org/grpc/metadata"
@@ -20,11 +21,34 @@ import (
 // and a Public Client (the client consumed by the HTTP API)
 type drandProxy struct {
 	r drand.PublicServer
+
+	// the scheme of the chain, fetched on first use, which the randomness is derived with
+	schLk sync.Mutex
+	sch   *crypto.Scheme
 }
 
 // Proxy wraps a server interface into a client interface so it can be queried
 func Proxy(s drand.PublicServer) client.Client {
-	return &drandProxy{s}
+	return &drandProxy{r: s}
+}
+
+// scheme returns the scheme of the chain served, which doesn't change over the life of the chain
+func (d *drandProxy) scheme(ctx context.Context) (*crypto.Scheme, error) {
+	d.schLk.Lock()
+	defer d.schLk.Unlock()
+	if d.sch != nil {
+		return d.sch, nil
+	}
+	info, err := d.r.ChainInfo(ctx, &drand.ChainInfoRequest{})
+	if err != nil {
+		return nil, err
+	}
+	sch, err := crypto.GetSchemeByID(info.GetSchemeID())
+	if err != nil {
+		return nil, err
+	}
+	d.sch = sch
+	return sch, nil
 }
 
 // String returns the name of this proxy.
@@ -38,14 +62,51 @@ func (d *drandProxy) Get(ctx context.Context, round uint64) (client.Result, erro
 	if err != nil {
 		return nil, err
 	}
+	sch, err := d.scheme(ctx)
+	if err != nil {
+		return nil, err
+	}
 	// we don't need to return the metadata to the public
 	resp.Metadata = nil
 	// we need to set the randomness now since it isn't sent over the wire anymore in V2
-	resp.Randomness = crypto.RandomnessFromSignature(resp.GetSignature())
+	resp.Randomness = sch.RandomnessFromSignature(resp.GetSignature())
 
 	return resp, err
 }
 
+// beaconRanger is implemented by the public servers able to iterate over the beacons they store
+type beaconRanger interface {
+	BeaconRange(ctx context.Context, from, to uint64, fn func(*common.Beacon) error) error
+}
+
+// Range calls fn on the randomness of each round from `from` to `to` included, in order, stopping at the
+// first error. It reads the beacons straight from the node's store when possible.
+func (d *drandProxy) Range(ctx context.Context, from, to uint64, fn func(client.Result) error) error {
+	r, ok := d.r.(beaconRanger)
+	if !ok {
+		for round := from; round <= to; round++ {
+			resp, err := d.Get(ctx, round)
+			if err != nil {
+				return err
+			}
+			if err := fn(resp); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	sch, err := d.scheme(ctx)
+	if err != nil {
+		return err
+	}
+	return r.BeaconRange(ctx, from, to, func(b *common.Beacon) error {
+		resp := beaconToProto(b)
+		resp.Randomness = sch.RandomnessFromSignature(resp.GetSignature())
+		return fn(resp)
+	})
+}
+
 // Watch returns new randomness as it becomes available.
 func (d *drandProxy) Watch(ctx context.Context) <-chan client.Result {
 	proxy := newStreamProxy(ctx)
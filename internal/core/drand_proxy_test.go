@@ -0,0 +1,167 @@
+package core
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	json "github.com/nikkolasg/hexjson"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/v2/common"
+	"github.com/drand/drand/v2/common/client"
+	"github.com/drand/drand/v2/crypto"
+	dhttp "github.com/drand/drand/v2/handler/http"
+	"github.com/drand/drand/v2/protobuf/drand"
+	"github.com/drand/kyber/util/random"
+)
+
+// storedChainServer serves a few beacons the way the beacon process does: without their randomness on the wire,
+// and with it derived by the proxyStream on the stream
+type storedChainServer struct {
+	drand.UnimplementedPublicServer
+	sch     *crypto.Scheme
+	public  []byte
+	genesis int64
+	beacons []*common.Beacon
+}
+
+func newStoredChainServer(t *testing.T, sch *crypto.Scheme, rounds int) *storedChainServer {
+	t.Helper()
+	public, err := sch.KeyGroup.Point().Pick(random.New()).MarshalBinary()
+	require.NoError(t, err)
+	s := &storedChainServer{sch: sch, public: public, genesis: time.Now().Unix() - int64(rounds)}
+	for round := 1; round <= rounds; round++ {
+		// the randomness doesn't depend on the signature being valid
+		sig := make([]byte, 48)
+		for i := range sig {
+			sig[i] = byte(round*7 + i)
+		}
+		s.beacons = append(s.beacons, &common.Beacon{Round: uint64(round), Signature: sig})
+	}
+	return s
+}
+
+func (s *storedChainServer) PublicRand(_ context.Context, in *drand.PublicRandRequest) (*drand.PublicRandResponse, error) {
+	round := in.GetRound()
+	if round == 0 {
+		round = uint64(len(s.beacons))
+	}
+	return beaconToProto(s.beacons[round-1]), nil
+}
+
+func (s *storedChainServer) PublicRandStream(_ *drand.PublicRandRequest, stream drand.Public_PublicRandStreamServer) error {
+	b := s.beacons[len(s.beacons)-1]
+	proxyStr := &proxyStream{Public_PublicRandStreamServer: stream, sch: s.sch}
+	if err := proxyStr.Send(&drand.BeaconPacket{Round: b.Round, Signature: b.Signature}); err != nil {
+		return err
+	}
+	<-stream.Context().Done()
+	return nil
+}
+
+func (s *storedChainServer) ChainInfo(context.Context, *drand.ChainInfoRequest) (*drand.ChainInfoPacket, error) {
+	return &drand.ChainInfoPacket{
+		PublicKey:   s.public,
+		GenesisTime: s.genesis,
+		Period:      1,
+		SchemeID:    s.sch.Name,
+	}, nil
+}
+
+func (s *storedChainServer) BeaconRange(_ context.Context, from, to uint64, fn func(*common.Beacon) error) error {
+	for round := from; round <= to; round++ {
+		if err := fn(s.beacons[round-1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rangeOnlyServer hides the BeaconRange of the server, for the proxy to get the rounds one by one
+type rangeOnlyServer struct {
+	drand.PublicServer
+}
+
+func TestRandomnessAcrossAPIs(t *testing.T) {
+	const rounds = 5
+	for _, schemeID := range crypto.ListSchemes() {
+		t.Run(schemeID, func(t *testing.T) {
+			sch, err := crypto.SchemeFromName(schemeID)
+			require.NoError(t, err)
+			s := newStoredChainServer(t, sch, rounds)
+			expected := func(round uint64) []byte {
+				return sch.RandomnessFromSignature(s.beacons[round-1].Signature)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			for _, b := range s.beacons {
+				require.Equal(t, expected(b.Round), b.RandomnessFor(sch))
+				require.Equal(t, binary.BigEndian.Uint64(expected(b.Round)), client.NewRandomness(b, sch).Uint64())
+			}
+
+			proxy := Proxy(s)
+			res, err := proxy.Get(ctx, 2)
+			require.NoError(t, err)
+			require.Equal(t, expected(2), res.GetRandomness())
+
+			for _, p := range []client.Client{proxy, Proxy(&rangeOnlyServer{s})} {
+				next := uint64(1)
+				err = p.(*drandProxy).Range(ctx, 1, rounds, func(res client.Result) error {
+					require.Equal(t, expected(next), res.GetRandomness())
+					next++
+					return nil
+				})
+				require.NoError(t, err)
+				require.Equal(t, uint64(rounds+1), next)
+			}
+
+			res, ok := <-proxy.Watch(ctx)
+			require.True(t, ok)
+			require.Equal(t, expected(rounds), res.GetRandomness())
+
+			handler, err := dhttp.New(ctx, "")
+			require.NoError(t, err)
+			handler.RegisterNewBeaconHandler(proxy, common.DefaultChainHash)
+			server := httptest.NewServer(handler.GetHTTPHandler())
+			defer server.Close()
+
+			var latest struct {
+				Round      uint64 `json:"round"`
+				Randomness []byte `json:"randomness"`
+			}
+			resp := httpGet(ctx, t, server.URL+"/public/latest")
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&latest))
+			resp.Body.Close()
+			require.Equal(t, expected(rounds), latest.Randomness)
+
+			resp = httpGet(ctx, t, server.URL+"/chain/export?from=1")
+			defer resp.Body.Close()
+			scanner := bufio.NewScanner(resp.Body)
+			next := uint64(1)
+			for scanner.Scan() {
+				require.NoError(t, json.Unmarshal(scanner.Bytes(), &latest))
+				require.Equal(t, next, latest.Round)
+				require.Equal(t, expected(next), latest.Randomness)
+				next++
+			}
+			require.Equal(t, uint64(rounds+1), next)
+		})
+	}
+}
+
+func httpGet(ctx context.Context, t *testing.T, url string) *http.Response {
+	t.Helper()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	return resp
+}
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -31,6 +32,7 @@ import (
 	"github.com/drand/drand/v2/internal/net"
 	"github.com/drand/drand/v2/internal/test"
 	context2 "github.com/drand/drand/v2/internal/test/context"
+	"github.com/drand/drand/v2/internal/util"
 	"github.com/drand/drand/v2/protobuf/drand"
 )
 
@@ -117,6 +119,47 @@ func TestRunDKG(t *testing.T) {
 	assert.Equal(t, n, len(group.Nodes))
 }
 
+// TestRunDKGInProcess runs a DKG through the Go API of the daemons, without the control client
+func TestRunDKGInProcess(t *testing.T) {
+	n := 3
+	beaconID := test.GetBeaconIDFromEnv()
+	dt := NewDrandTestScenario(t, n, key.DefaultThreshold(n), time.Second, beaconID, clockwork.NewFakeClock())
+	ctx := context.Background()
+
+	joiners := make([]*pdkg.Participant, n)
+	for i, node := range dt.nodes {
+		p, err := util.PublicKeyAsParticipant(node.drand.priv.Public)
+		require.NoError(t, err)
+		joiners[i] = p
+	}
+	leader, followers := dt.nodes[0], dt.nodes[1:]
+	proposal := &pdkg.FirstProposalOptions{
+		Timeout:       timestamppb.New(dt.clock.Now().Add(time.Minute)),
+		Threshold:     1,
+		PeriodSeconds: 1,
+		Scheme:        dt.scheme.Name,
+		GenesisTime:   timestamppb.New(dt.clock.Now().Add(dkg.GenesisDelay)),
+		Joining:       joiners,
+	}
+
+	// the errors of the state machine can be told apart
+	err := leader.daemon.ProposeDKG(ctx, beaconID, proposal)
+	require.ErrorIs(t, err, dkg.ErrThresholdTooLow)
+
+	proposal.Threshold = uint32(key.DefaultThreshold(n))
+	require.NoError(t, leader.daemon.ProposeDKG(ctx, beaconID, proposal))
+	for _, follower := range followers {
+		require.NoError(t, follower.daemon.JoinDKG(ctx, beaconID, nil))
+	}
+	require.ErrorIs(t, followers[0].daemon.ExecuteDKG(ctx, beaconID), dkg.ErrOnlyLeaderCanTriggerExecute)
+	require.NoError(t, leader.daemon.ExecuteDKG(ctx, beaconID))
+
+	dt.AdvanceMockClock(t, leader.daemon.opts.dkgKickoffGracePeriod)
+	group, err := dt.WaitForDKG(t, leader, 1, 60)
+	require.NoError(t, err)
+	require.Equal(t, n, group.Len())
+}
+
 // Test dkg for a large quantity of nodes (22 nodes)
 func TestRunDKGLarge(t *testing.T) {
 	if testing.Short() {
@@ -219,6 +262,83 @@ func TestDrandDKGFresh(t *testing.T) {
 	require.Equal(t, uint64(2), response.Round)
 }
 
+// This tests a node killed in the middle of the DKG execution, once the deals are out, and restarted before the
+// execution is over: it must resume the execution and end up with its share in the group
+//
+//nolint:funlen
+func TestRunDKGResumeAfterRestart(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping slow test in short mode.")
+	}
+
+	ctx := context.Background()
+	n := 5
+	beaconID := test.GetBeaconIDFromEnv()
+	dt := NewDrandTestScenario(t, n, key.DefaultThreshold(n), time.Second, beaconID, clockwork.NewFakeClockAt(time.Now()))
+
+	joiners := make([]*pdkg.Participant, n)
+	for i, node := range dt.nodes {
+		p, err := util.PublicKeyAsParticipant(node.drand.priv.Public)
+		require.NoError(t, err)
+		joiners[i] = p
+	}
+
+	leader := dt.nodes[0]
+	restarted := dt.nodes[n-2]
+	absent := dt.nodes[n-1]
+	require.NoError(t, leader.dkgRunner.StartNetwork(dt.thr, 1, dt.scheme.Name, 5*time.Minute, 1, joiners))
+	for _, follower := range dt.nodes[1:] {
+		require.NoError(t, follower.dkgRunner.JoinDKG())
+	}
+
+	// without the deal of the absent node, the nodes can't move on to the next phase before its time
+	require.NoError(t, absent.daemon.Stop(ctx))
+	<-absent.daemon.WaitExit()
+	require.NoError(t, leader.dkgRunner.StartExecution())
+	opts := restarted.daemon.opts
+	executionStart := time.Now().Add(opts.dkgKickoffGracePeriod)
+
+	// the deals are sent out as soon as the execution starts: kill the node once they are out, well before the
+	// response phase
+	time.Sleep(time.Until(executionStart) + opts.dkgPhaseTimeout/4)
+	t.Logf("Stopping node %s during the DKG execution", restarted.addr)
+	require.NoError(t, restarted.daemon.Stop(ctx))
+	<-restarted.daemon.WaitExit()
+
+	// the ports of the stopped daemon may take a moment to be released
+	var daemon *DrandDaemon
+	require.Eventually(t, func() bool {
+		var err error
+		daemon, err = NewDrandDaemon(ctx, opts)
+		return err == nil
+	}, opts.dkgPhaseTimeout/2, 100*time.Millisecond)
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		require.NoError(t, daemon.Stop(ctx))
+	})
+	_, err := daemon.LoadBeaconFromStore(ctx, beaconID, restarted.drand.store)
+	require.NoError(t, err)
+	// the node was down while the group was still waiting for the deals, it has to resume from what it persisted
+	require.Less(t, time.Since(executionStart), opts.dkgPhaseTimeout)
+	t.Logf("Restarted node %s", restarted.addr)
+
+	group, err := dt.WaitForDKG(t, leader, 1, 60)
+	require.NoError(t, err)
+	require.Len(t, group.Nodes, n-1)
+
+	// the restarted node completes the execution it resumed, with the same result as the others
+	require.NoError(t, restarted.dkgRunner.WaitForDKG(daemon.log, 1, 60))
+	bp, ok := daemon.beaconProcesses[beaconID]
+	require.True(t, ok)
+	require.NotNil(t, bp.group)
+	require.True(t, group.PublicKey.Equal(bp.group.PublicKey))
+	require.NotNil(t, bp.group.Find(restarted.drand.priv.Public))
+	share, err := restarted.drand.store.LoadShare()
+	require.NoError(t, err)
+	require.NotNil(t, share)
+}
+
 // Test dkg when two nodes cannot broadcast messages between them. The rest of the nodes
 // will be able to broadcast messages, so the process should finish successfully
 // Given 4 nodes = [0, 1, 2, 3]
@@ -303,7 +423,7 @@ func TestRunDKGReshareAbsentNodeDuringExecution(t *testing.T) {
 	hooks := lifecycleHooks{
 		postExecutionStart: func() {
 			t.Logf("Stopping node %d for test: %s \n", nodeIndexToStop, nodeToStop.addr)
-			nodeToStop.daemon.Stop(context.Background())
+			require.NoError(t, nodeToStop.daemon.Stop(context.Background()))
 			<-nodeToStop.daemon.WaitExit()
 			t.Logf("Node %d stopped \n", nodeIndexToStop)
 		},
@@ -361,7 +481,7 @@ func TestRunDKGReshareAbsentNodeForExecutionStart(t *testing.T) {
 	hooks := lifecycleHooks{
 		postAcceptance: func() {
 			t.Logf("Stopping node for test: %s \n", nodeToStop.addr)
-			nodeToStop.daemon.Stop(context.Background())
+			require.NoError(t, nodeToStop.daemon.Stop(context.Background()))
 			<-nodeToStop.daemon.WaitExit()
 			t.Logf("Node %d stopped \n", nodeIndexToStop)
 		},
@@ -980,7 +1100,7 @@ func TestDrandFollowChain(t *testing.T) {
 		// check if the beacon is in the database
 		store := newNode.drand.dbStore
 		if newNode.drand.opts.dbStorageEngine == chain.BoltDB {
-			store, err = newNode.drand.createDBStore(ctx)
+			store, err = newNode.drand.createDBStore(ctx, group.GenesisTime, group.Period)
 			require.NoError(t, err)
 		}
 		require.NoError(t, err)
@@ -998,6 +1118,106 @@ func TestDrandFollowChain(t *testing.T) {
 	fn(0, resp.GetRound())
 }
 
+// This test makes sure a read-only node doesn't take part in DKGs and serves the chain it follows
+func TestDrandReadOnlyMirror(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode.")
+	}
+	n, p := 4, 1*time.Second
+	beaconID := test.GetBeaconIDFromEnv()
+
+	dt := NewDrandTestScenario(t, n, key.DefaultThreshold(n), p, beaconID, clockwork.NewFakeClockAt(time.Now()))
+
+	group, err := dt.RunDKG(t)
+	require.NoError(t, err)
+	rootID := dt.nodes[0].drand.priv.Public
+
+	dt.SetMockClock(t, group.GenesisTime)
+	require.NoError(t, dt.WaitUntilChainIsServing(t, dt.nodes[0]))
+	for i := 0; i < 3; i++ {
+		dt.AdvanceMockClock(t, group.Period)
+		require.NoError(t, dt.WaitUntilRound(t, dt.nodes[0], uint64(i+2)))
+	}
+
+	mirror := dt.SetupNewNodes(t, 1, WithReadOnly(), WithSyncSources([]string{rootID.Address()}))[0]
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// it refuses to take part in a DKG or in the beacon generation
+	err = mirror.daemon.JoinDKG(ctx, beaconID, nil)
+	require.ErrorIs(t, err, ErrReadOnly)
+	_, err = mirror.drand.PartialBeacon(ctx, &drand.PartialBeaconPacket{Round: 2})
+	require.ErrorIs(t, err, ErrReadOnly)
+
+	// it serves nothing until it follows the chain
+	client := net.NewGrpcClient(mirror.drand.log)
+	_, err = client.PublicRand(ctx, mirror.drand.priv.Public, new(drand.PublicRandRequest))
+	require.Error(t, err)
+
+	control, err := net.NewControlClient(mirror.drand.log, mirror.drand.opts.controlPort)
+	require.NoError(t, err)
+	hash := fmt.Sprintf("%x", public.NewChainInfo(group).Hash())
+	last, err := client.PublicRand(ctx, rootID, new(drand.PublicRandRequest))
+	require.NoError(t, err)
+	upTo := last.GetRound()
+
+	followCtx, followCancel := context.WithCancel(ctx)
+	progress, errCh, err := control.StartFollowChain(followCtx, hash, []string{rootID.Address()}, upTo, beaconID)
+	require.NoError(t, err)
+	for synced := false; !synced; {
+		select {
+		case p, ok := <-progress:
+			synced = ok && p.Current == upTo
+		case e := <-errCh:
+			synced = errors.Is(e, io.EOF)
+			require.True(t, synced, "unexpected error while following: %v", e)
+		case <-time.After(2 * time.Second):
+			t.Fatal("timeout while following the chain")
+		}
+	}
+	followCancel()
+
+	// it keeps serving the synced rounds once the follow is done
+	resp, err := client.PublicRand(ctx, mirror.drand.priv.Public, &drand.PublicRandRequest{Round: upTo})
+	require.NoError(t, err)
+	require.Equal(t, last.GetSignature(), resp.GetSignature())
+	info, err := client.ChainInfo(ctx, mirror.drand.priv.Public, new(drand.ChainInfoRequest))
+	require.NoError(t, err)
+	require.Equal(t, public.NewChainInfo(group).Hash(), info.GetHash())
+
+	// once restarted, it serves the chain it mirrored without being told to follow it again
+	opts := mirror.daemon.opts
+	require.NoError(t, mirror.daemon.Stop(ctx))
+	<-mirror.daemon.WaitExit()
+	var daemon *DrandDaemon
+	require.Eventually(t, func() bool {
+		daemon, err = NewDrandDaemon(ctx, opts)
+		return err == nil
+	}, 10*time.Second, 100*time.Millisecond)
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		require.NoError(t, daemon.Stop(ctx))
+	})
+	_, err = daemon.LoadBeaconFromStore(ctx, beaconID, mirror.drand.store)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		resp, err := client.PublicRand(ctx, mirror.drand.priv.Public, &drand.PublicRandRequest{Round: upTo})
+		return err == nil && bytes.Equal(last.GetSignature(), resp.GetSignature())
+	}, 10*time.Second, 100*time.Millisecond)
+	info, err = client.ChainInfo(ctx, mirror.drand.priv.Public, new(drand.ChainInfoRequest))
+	require.NoError(t, err)
+	require.Equal(t, public.NewChainInfo(group).Hash(), info.GetHash())
+
+	// and it keeps syncing new rounds from its sync sources
+	dt.AdvanceMockClock(t, group.Period)
+	require.NoError(t, dt.WaitUntilRound(t, dt.nodes[0], upTo+1))
+	require.Eventually(t, func() bool {
+		resp, err := client.PublicRand(ctx, mirror.drand.priv.Public, &drand.PublicRandRequest{Round: upTo + 1})
+		return err == nil && resp.GetRound() == upTo+1
+	}, 10*time.Second, 100*time.Millisecond)
+}
+
 // This test makes sure the "StartCheckChain" grpc method works fine
 //
 //nolint:funlen
@@ -1081,7 +1301,7 @@ func TestDrandCheckChain(t *testing.T) {
 	t.Logf(" \t\t --> Done, proceeding to modify store now.\n")
 	store := dt.nodes[0].drand.dbStore
 	if dt.nodes[0].drand.opts.dbStorageEngine == chain.BoltDB {
-		store, err = dt.nodes[0].drand.createDBStore(ctx)
+		store, err = dt.nodes[0].drand.createDBStore(ctx, group.GenesisTime, group.Period)
 		require.NoError(t, err)
 	}
 
@@ -1183,7 +1403,7 @@ func TestDrandPublicStreamProxy(t *testing.T) {
 		require.NoError(t, err)
 	}
 
-	client := &drandProxy{root.drand}
+	client := &drandProxy{r: root.drand}
 	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
 	defer cancel()
 
@@ -1254,7 +1474,7 @@ func TestModifyingGroupFileManuallyDoesNotSegfault(t *testing.T) {
 	require.NoError(t, err)
 
 	// stop the node and wait for it
-	node.daemon.Stop(ctx)
+	require.NoError(t, node.daemon.Stop(ctx))
 	<-node.daemon.exitCh
 	// although the exit channel has signaled exit, the control client is stopped out of band
 	// without waiting the pessimistic closing time, we may try and restart the daemon below
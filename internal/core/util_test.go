@@ -135,7 +135,7 @@ func BatchNewDrand(t *testing.T, currentNodeCount, n int,
 		t.Cleanup(func() {
 			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 			defer cancel()
-			daemon.Stop(ctx)
+			require.NoError(t, daemon.Stop(ctx))
 		})
 	}
 
@@ -441,7 +441,9 @@ func (d *DrandTestScenario) RunFailingReshare() error {
 			return err
 		}
 
-		follower.daemon.Stop(context.Background())
+		if err := follower.daemon.Stop(context.Background()); err != nil {
+			return err
+		}
 		<-follower.daemon.exitCh
 	}
 
@@ -79,7 +79,7 @@ func (d *Process) Command(ctx context.Context, command *drand.DKGCommand) (*dran
 	case *drand.DKGCommand_Execute:
 		afterState, packetToGossip, err = d.StartExecute(ctx, beaconID, me, currentState, c.Execute)
 	case *drand.DKGCommand_Abort:
-		afterState, packetToGossip, err = d.StartAbort(ctx, beaconID, currentState, c.Abort)
+		afterState, packetToGossip, err = d.StartAbort(ctx, beaconID, me, currentState, c.Abort)
 	default:
 		return nil, errors.New("unrecognized DKG command")
 	}
@@ -270,22 +270,8 @@ func (d *Process) StartProposal(
 		}
 	}
 
-	terms := drand.ProposalTerms{
-		BeaconID:             beaconID,
-		Threshold:            options.Threshold,
-		Epoch:                currentState.Epoch + 1,
-		SchemeID:             currentState.SchemeID,
-		BeaconPeriodSeconds:  uint32(currentState.BeaconPeriod.Seconds()),
-		CatchupPeriodSeconds: options.CatchupPeriodSeconds,
-		GenesisTime:          timestamppb.New(currentState.GenesisTime),
-		GenesisSeed:          currentState.GenesisSeed,
-		Timeout:              options.Timeout,
-		Leader:               me,
-		Joining:              options.Joining,
-		Remaining:            options.Remaining,
-		Leaving:              options.Leaving,
-	}
-	nextState, err := currentState.Proposing(me, &terms)
+	terms := ReshareTerms(beaconID, me, currentState, options)
+	nextState, err := currentState.Proposing(me, terms)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -298,15 +284,40 @@ func (d *Process) StartProposal(
 	return nextState,
 		&drand.GossipPacket{
 			Packet: &drand.GossipPacket_Proposal{
-				Proposal: &terms,
+				Proposal: terms,
 			},
 		},
 		nil
 }
 
+// ReshareTerms returns the terms of the proposal led by `me` that reshares the network of the current state
+// with the given options
+func ReshareTerms(beaconID string, me *drand.Participant, currentState *DBState, options *drand.ProposalOptions) *drand.ProposalTerms {
+	return &drand.ProposalTerms{
+		BeaconID:             beaconID,
+		Threshold:            options.Threshold,
+		Epoch:                currentState.Epoch + 1,
+		SchemeID:             currentState.SchemeID,
+		BeaconPeriodSeconds:  uint32(currentState.BeaconPeriod.Seconds()),
+		CatchupPeriodSeconds: options.CatchupPeriodSeconds,
+		GenesisTime:          timestamppb.New(currentState.GenesisTime),
+		GenesisSeed:          currentState.GenesisSeed,
+		Timeout:              options.Timeout,
+		Leader:               me,
+		Joining:              options.Joining,
+		Remaining:            options.Remaining,
+		Leaving:              options.Leaving,
+	}
+}
+
+// StartAbort aborts the current DKG. When the leader aborts, the abort is gossiped so that the other participants
+// abort too and the leader can propose new terms straight away, rather than waiting for the DKG to time out.
+// Participants only ever abort locally, e.g. to recover from a failed DKG: the other nodes would reject their abort
+// with ErrOnlyLeaderCanRemoteAbort anyway.
 func (d *Process) StartAbort(
 	ctx context.Context,
 	beaconID string,
+	me *drand.Participant,
 	current *DBState,
 	_ *drand.AbortOptions,
 ) (*DBState, *drand.GossipPacket, error) {
@@ -323,6 +334,10 @@ func (d *Process) StartAbort(
 		return nil, nil, err
 	}
 
+	if !util.EqualParticipant(nextState.Leader, me) {
+		return nextState, nil, nil
+	}
+
 	return nextState, &drand.GossipPacket{
 		Packet: &drand.GossipPacket_Abort{
 			Abort: &drand.AbortDKG{Reason: "none"},
@@ -351,7 +366,7 @@ func (d *Process) StartExecute(
 	}
 
 	kickoffTime := time.Now().Add(d.config.KickoffGracePeriod)
-	err = d.executeDKG(ctx, beaconID, kickoffTime)
+	err = d.executeDKG(ctx, beaconID, kickoffTime, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -454,6 +469,19 @@ func (d *Process) StartReject(
 	}, nil
 }
 
+// DKGState returns the whole current DKG state of the beacon, except for its key share which must not leave the
+// process
+func (d *Process) DKGState(ctx context.Context, request *drand.DKGStatusRequest) (*drand.DKGStateResponse, error) {
+	_, span := tracer.NewSpan(ctx, "dkg.State")
+	defer span.End()
+
+	current, err := d.store.GetCurrent(request.BeaconID)
+	if err != nil {
+		return nil, err
+	}
+	return current.ToProto(), nil
+}
+
 func (d *Process) DKGStatus(ctx context.Context, request *drand.DKGStatusRequest) (*drand.DKGStatusResponse, error) {
 	_, span := tracer.NewSpan(ctx, "dkg.Status")
 	defer span.End()
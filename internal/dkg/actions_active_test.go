@@ -453,6 +453,57 @@ func TestJoin(t *testing.T) {
 	}
 }
 
+func TestAbort(t *testing.T) {
+	beaconID := "someBeaconID"
+	alice := NewParticipant("alice")
+	bob := NewParticipant("bob")
+
+	tests := []struct {
+		name          string
+		me            *drand.Participant
+		expectGossip  bool
+		expectedState Status
+	}{
+		{
+			name:          "leader abort is gossiped to the participants",
+			me:            alice,
+			expectGossip:  true,
+			expectedState: Aborted,
+		},
+		{
+			name:          "participant abort is only applied locally",
+			me:            bob,
+			expectGossip:  false,
+			expectedState: Aborted,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			store := MockStore{}
+			store.On("SaveCurrent", beaconID, mock.Anything).Return(nil)
+			process := Process{
+				store:       &store,
+				log:         log.New(nil, log.DebugLevel, true),
+				SeenPackets: make(map[string]bool),
+				config:      Config{},
+				close:       make(chan struct{}, 1),
+			}
+
+			current := NewCompleteDKGEntry(t, beaconID, Proposing, alice, bob)
+			state, packet, err := process.StartAbort(context.Background(), beaconID, test.me, current, &drand.AbortOptions{})
+			require.NoError(t, err)
+			require.Equal(t, test.expectedState, state.State)
+			if test.expectGossip {
+				require.NotNil(t, packet.GetAbort())
+			} else {
+				require.Nil(t, packet)
+			}
+			store.AssertNumberOfCalls(t, "SaveCurrent", 1)
+		})
+	}
+}
+
 type MockIdentityProvider struct {
 	mock.Mock
 }
@@ -7,10 +7,12 @@ import (
 	"fmt"
 	"math/rand"
 	"sync"
+	"time"
 
 	"github.com/drand/drand/v2/common/log"
 	"github.com/drand/drand/v2/common/tracer"
 	"github.com/drand/drand/v2/crypto"
+	"github.com/drand/drand/v2/internal/metrics"
 	"github.com/drand/drand/v2/internal/net"
 	"github.com/drand/drand/v2/internal/util"
 	pdkg "github.com/drand/drand/v2/protobuf/dkg"
@@ -63,6 +65,13 @@ type echoBroadcast struct {
 	scheme    *crypto.Scheme
 	config    dkg.Config
 	isStopped bool
+	// persist, when set, records every packet sent out so that the execution can be resumed after a restart.
+	// It writes to disk, so it is called without holding the lock.
+	persist func(p *pdkg.Packet, own bool)
+	// resumed holds our own packets sent out before a restart, by packet type
+	resumed map[string]packet
+	// replayed holds the packets of the other nodes seen before a restart, until a replayBoard hands them over
+	replayed []packet
 }
 
 type packet = dkg.Packet
@@ -78,6 +87,7 @@ func newEchoBroadcast(
 	to []*pdkg.Participant,
 	scheme *crypto.Scheme,
 	config *dkg.Config,
+	retry retryPolicy,
 ) (*echoBroadcast, error) {
 	if len(to) == 0 {
 		return nil, errors.New("cannot create a broadcaster with no participants")
@@ -88,7 +98,7 @@ func newEchoBroadcast(
 		ctx:        ctx,
 		l:          l.Named("echoBroadcast"),
 		beaconID:   beaconID,
-		dispatcher: newDispatcher(ctx, client, l, to, own),
+		dispatcher: newDispatcher(ctx, client, l, beaconID, to, own, retry),
 		dealCh:     make(chan dkg.DealBundle, len(to)),
 		respCh:     make(chan dkg.ResponseBundle, len(to)),
 		justCh:     make(chan dkg.JustificationBundle, len(to)),
@@ -96,6 +106,7 @@ func newEchoBroadcast(
 		scheme:     scheme,
 		config:     c,
 		isStopped:  false,
+		resumed:    make(map[string]packet),
 	}, nil
 }
 
@@ -103,7 +114,9 @@ func (b *echoBroadcast) PushDeals(bundle *dkg.DealBundle) {
 	ctx, span := tracer.NewSpan(b.ctx, "b.PushDeals")
 	defer span.End()
 
+	bundle = b.resumedPacket(bundle).(*dkg.DealBundle)
 	b.dealCh <- *bundle
+	b.persistPacket(bundle, true)
 	b.Lock()
 	defer b.Unlock()
 	h := hash(bundle.Hash())
@@ -115,7 +128,9 @@ func (b *echoBroadcast) PushResponses(bundle *dkg.ResponseBundle) {
 	ctx, span := tracer.NewSpan(b.ctx, "b.PushResponses")
 	defer span.End()
 
+	bundle = b.resumedPacket(bundle).(*dkg.ResponseBundle)
 	b.respCh <- *bundle
+	b.persistPacket(bundle, true)
 	b.Lock()
 	defer b.Unlock()
 	h := hash(bundle.Hash())
@@ -127,7 +142,9 @@ func (b *echoBroadcast) PushJustifications(bundle *dkg.JustificationBundle) {
 	ctx, span := tracer.NewSpan(b.ctx, "b.PushJustifications")
 	defer span.End()
 
+	bundle = b.resumedPacket(bundle).(*dkg.JustificationBundle)
 	b.justCh <- *bundle
+	b.persistPacket(bundle, true)
 	b.Lock()
 	defer b.Unlock()
 	h := hash(bundle.Hash())
@@ -139,6 +156,20 @@ func (b *echoBroadcast) BroadcastDKG(ctx context.Context, p *pdkg.DKGPacket) err
 	ctx, span := tracer.NewSpan(ctx, "b.BroadcastDKG")
 	defer span.End()
 
+	dkgPacket, err := b.echo(ctx, p)
+	if err != nil || dkgPacket == nil {
+		return err
+	}
+	b.persistPacket(dkgPacket, false)
+	return nil
+}
+
+// echo verifies a packet received from another node and, if it's the first time we see it, rebroadcasts it and
+// passes it to the application. It returns the packet, or nil if we had already seen it.
+func (b *echoBroadcast) echo(ctx context.Context, p *pdkg.DKGPacket) (packet, error) {
+	ctx, span := tracer.NewSpan(ctx, "b.echo")
+	defer span.End()
+
 	b.Lock()
 	defer b.Unlock()
 
@@ -148,7 +179,7 @@ func (b *echoBroadcast) BroadcastDKG(ctx context.Context, p *pdkg.DKGPacket) err
 		b.l.Errorw("received invalid packet DKGPacket", "from", addr, "err", err)
 		err := errors.New("invalid DKGPacket")
 		span.RecordError(err)
-		return err
+		return nil, err
 	}
 
 	hash := hash(dkgPacket.Hash())
@@ -156,7 +187,7 @@ func (b *echoBroadcast) BroadcastDKG(ctx context.Context, p *pdkg.DKGPacket) err
 		// if we've already seen this one, no need to verify even because that
 		// means we already broadcasted it
 		b.l.Debugw("ignoring duplicate packet", "index", dkgPacket.Index(), "from", addr, "type", fmt.Sprintf("%T", dkgPacket))
-		return nil
+		return nil, nil
 	}
 
 	dkgConfig := b.config
@@ -164,13 +195,26 @@ func (b *echoBroadcast) BroadcastDKG(ctx context.Context, p *pdkg.DKGPacket) err
 		b.l.Errorw("received invalid signature", "from", addr, "signature", dkgPacket.Sig(), "scheme", b.scheme, "err", err)
 		err := errors.New("invalid DKGPacket")
 		span.RecordError(err)
-		return err
+		return nil, err
 	}
 
 	b.l.Debugw("received new packet to echoBroadcast", "from", addr, "packet index", dkgPacket.Index(), "type", fmt.Sprintf("%T", dkgPacket))
 	b.sendout(ctx, hash, dkgPacket, false, b.beaconID) // we're using the rate limiting
 	b.passToApplication(dkgPacket)
-	return nil
+	return dkgPacket, nil
+}
+
+// persistPacket records a packet of the execution if persist is set. It must be called without the lock.
+func (b *echoBroadcast) persistPacket(p packet, own bool) {
+	if b.persist == nil {
+		return
+	}
+	dkgproto, err := dkgPacketToProto(p, b.beaconID)
+	if err != nil {
+		b.l.Errorw("can't persist packet", "err", err)
+		return
+	}
+	b.persist(dkgproto, own)
 }
 
 func (b *echoBroadcast) passToApplication(p packet) {
@@ -291,7 +335,15 @@ type dispatcher struct {
 	senders []*sender
 }
 
-func newDispatcher(ctx context.Context, dkgClient net.DKGClient, l log.Logger, to []*pdkg.Participant, us string) *dispatcher {
+func newDispatcher(
+	ctx context.Context,
+	dkgClient net.DKGClient,
+	l log.Logger,
+	beaconID string,
+	to []*pdkg.Participant,
+	us string,
+	retry retryPolicy,
+) *dispatcher {
 	ctx, span := tracer.NewSpan(ctx, "newDispatcher")
 	defer span.End()
 
@@ -301,7 +353,7 @@ func newDispatcher(ctx context.Context, dkgClient net.DKGClient, l log.Logger, t
 		if node.Address == us {
 			continue
 		}
-		sender := newSender(dkgClient, node, l, queue)
+		sender := newSender(dkgClient, beaconID, node, l, queue, retry)
 		go sender.run(ctx)
 		senders = append(senders, sender)
 	}
@@ -322,14 +374,22 @@ func (d *dispatcher) broadcast(ctx context.Context, p broadcastPacket) {
 }
 
 // broadcastDirect directly send to the other peers - it is used only for our
-// own packets so we're not bound to congestion events.
+// own packets so we're not bound to congestion events. Each peer is sent to
+// concurrently, so that the retransmissions to a peer that is down don't delay
+// the packet for the others.
 func (d *dispatcher) broadcastDirect(ctx context.Context, p broadcastPacket) {
 	ctx, span := tracer.NewSpan(ctx, "d.broadcastDirect")
 	defer span.End()
 
-	for _, i := range rand.Perm(len(d.senders)) {
-		d.senders[i].sendDirect(ctx, p)
+	var wg sync.WaitGroup
+	for _, s := range d.senders {
+		wg.Add(1)
+		go func(s *sender) {
+			defer wg.Done()
+			s.sendDirect(ctx, p)
+		}(s)
 	}
+	wg.Wait()
 }
 
 func (d *dispatcher) stop() {
@@ -338,19 +398,32 @@ func (d *dispatcher) stop() {
 	}
 }
 
+// retryPolicy defines how a sender resends a packet its destination failed to receive
+type retryPolicy struct {
+	retries  int
+	interval time.Duration
+}
+
 type sender struct {
-	l      log.Logger
-	client net.DKGClient
-	to     *pdkg.Participant
-	newCh  chan broadcastPacket
+	l        log.Logger
+	client   net.DKGClient
+	beaconID string
+	to       *pdkg.Participant
+	newCh    chan broadcastPacket
+	retry    retryPolicy
+	// done is closed when the sender is stopped, to abandon pending retransmissions
+	done chan struct{}
 }
 
-func newSender(client net.DKGClient, to *pdkg.Participant, l log.Logger, queueSize int) *sender {
+func newSender(client net.DKGClient, beaconID string, to *pdkg.Participant, l log.Logger, queueSize int, retry retryPolicy) *sender {
 	return &sender{
-		l:      l.Named("Sender"),
-		client: client,
-		to:     to,
-		newCh:  make(chan broadcastPacket, queueSize),
+		l:        l.Named("Sender"),
+		client:   client,
+		beaconID: beaconID,
+		to:       to,
+		newCh:    make(chan broadcastPacket, queueSize),
+		retry:    retry,
+		done:     make(chan struct{}),
 	}
 }
 
@@ -379,15 +452,31 @@ func (s *sender) sendDirect(ctx context.Context, newPacket broadcastPacket) {
 	defer span.End()
 
 	node := util.ToPeer(s.to)
-	_, err := s.client.BroadcastDKG(ctx, node, newPacket)
-	if err != nil {
-		s.l.Errorw("error while sending out", "to", s.to.Address, "err:", err)
-	} else {
-		s.l.Debugw("sending out", "to", s.to.Address)
+	for attempt := 0; ; attempt++ {
+		_, err := s.client.BroadcastDKG(ctx, node, newPacket)
+		if err == nil {
+			s.l.Debugw("sending out", "to", s.to.Address)
+			return
+		}
+		if attempt >= s.retry.retries {
+			s.l.Errorw("error while sending out", "to", s.to.Address, "attempts", attempt+1, "err:", err)
+			return
+		}
+		s.l.Warnw("error while sending out, retrying", "to", s.to.Address, "attempt", attempt+1, "err:", err)
+
+		select {
+		case <-time.After(s.retry.interval):
+		case <-s.done:
+			return
+		case <-ctx.Done():
+			return
+		}
+		metrics.DKGRetransmissions.WithLabelValues(s.beaconID, s.to.Address).Inc()
 	}
 }
 
 func (s *sender) stop() {
+	close(s.done)
 	close(s.newCh)
 }
 
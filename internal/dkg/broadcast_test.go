@@ -2,9 +2,13 @@ package dkg
 
 import (
 	"context"
+	"errors"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 
 	"github.com/drand/drand/v2/common/testlogger"
 	"github.com/drand/drand/v2/crypto"
@@ -27,6 +31,7 @@ func TestNewBroadcasterWithNoParticipantsFails(t *testing.T) {
 		[]*drand.Participant{},
 		sch,
 		&dkg.Config{},
+		retryPolicy{},
 	)
 	require.Error(t, err)
 }
@@ -52,6 +57,110 @@ func TestNewBroadcasterWithParticipantsDoesNotFail(t *testing.T) {
 		},
 		sch,
 		&dkg.Config{},
+		retryPolicy{},
 	)
 	require.NoError(t, err)
 }
+
+// flakyDKGClient fails to deliver the first packets it is given
+type flakyDKGClient struct {
+	net.DKGClient
+	failures int32
+	calls    atomic.Int32
+}
+
+func (f *flakyDKGClient) BroadcastDKG(context.Context, net.Peer, *drand.DKGPacket, ...grpc.CallOption) (*drand.EmptyDKGResponse, error) {
+	if f.calls.Add(1) <= f.failures {
+		return nil, errors.New("packet dropped")
+	}
+	return &drand.EmptyDKGResponse{}, nil
+}
+
+func TestSenderRetransmitsUndeliveredPackets(t *testing.T) {
+	l := testlogger.New(t)
+	ctx := context.Background()
+	to := &drand.Participant{Address: "127.0.0.1:1234"}
+	retry := retryPolicy{retries: 2, interval: time.Millisecond}
+
+	client := &flakyDKGClient{failures: 2}
+	s := newSender(client, "default", to, l, 1, retry)
+	s.sendDirect(ctx, &drand.DKGPacket{})
+	require.Equal(t, int32(3), client.calls.Load())
+
+	// the sender gives up after the configured retries
+	client = &flakyDKGClient{failures: 10}
+	s = newSender(client, "default", to, l, 1, retry)
+	s.sendDirect(ctx, &drand.DKGPacket{})
+	require.Equal(t, int32(3), client.calls.Load())
+
+	// and abandons pending retries once stopped
+	client = &flakyDKGClient{failures: 10}
+	s = newSender(client, "default", to, l, 1, retryPolicy{retries: 2, interval: time.Hour})
+	s.stop()
+	s.sendDirect(ctx, &drand.DKGPacket{})
+	require.Equal(t, int32(1), client.calls.Load())
+}
+
+func TestRetransmissionSpreadOverPhase(t *testing.T) {
+	c := Config{TimeBetweenDKGPhases: 10 * time.Second, BroadcastRetries: 3}
+	require.Equal(t, retryPolicy{retries: 3, interval: 2500 * time.Millisecond}, c.retransmission())
+
+	c.BroadcastRetryInterval = time.Second
+	require.Equal(t, time.Second, c.retransmission().interval)
+
+	require.Equal(t, retryPolicy{}, (&Config{TimeBetweenDKGPhases: time.Second}).retransmission())
+}
+
+// deadPeerDKGClient never delivers to the dead peer, and reports the peers it delivered to
+type deadPeerDKGClient struct {
+	net.DKGClient
+	dead      string
+	delivered chan string
+}
+
+func (c *deadPeerDKGClient) BroadcastDKG(_ context.Context, p net.Peer, _ *drand.DKGPacket, _ ...grpc.CallOption) (*drand.EmptyDKGResponse, error) {
+	if p.Address() == c.dead {
+		return nil, errors.New("peer is down")
+	}
+	c.delivered <- p.Address()
+	return &drand.EmptyDKGResponse{}, nil
+}
+
+func TestBroadcastDirectNotDelayedByDeadPeer(t *testing.T) {
+	l := testlogger.New(t)
+	ctx := context.Background()
+	to := []*drand.Participant{
+		{Address: "127.0.0.1:1230"},
+		{Address: "127.0.0.1:1231"},
+		{Address: "127.0.0.1:1232"},
+		{Address: "127.0.0.1:1233"},
+		{Address: "127.0.0.1:1234"},
+	}
+	client := &deadPeerDKGClient{dead: to[1].Address, delivered: make(chan string, len(to))}
+	// the retransmissions to the dead peer outlast the test
+	d := newDispatcher(ctx, client, l, "default", to, to[0].Address, retryPolicy{retries: 3, interval: time.Hour})
+
+	done := make(chan struct{})
+	go func() {
+		d.broadcastDirect(ctx, &drand.DKGPacket{})
+		close(done)
+	}()
+
+	// the live peers get the packet right away whatever the order they are sent to
+	for i := 0; i < len(to)-2; i++ {
+		select {
+		case addr := <-client.delivered:
+			require.NotEqual(t, client.dead, addr)
+		case <-time.After(time.Second):
+			require.FailNow(t, "a live peer didn't get the packet while retrying the dead one")
+		}
+	}
+
+	// stopping the dispatcher abandons the retransmissions
+	d.stop()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		require.FailNow(t, "the broadcast kept retrying once stopped")
+	}
+}
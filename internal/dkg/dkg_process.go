@@ -1,6 +1,7 @@
 package dkg
 
 import (
+	"io"
 	"sync"
 	"time"
 
@@ -40,6 +41,27 @@ type Config struct {
 
 	// whether or not to skip verifying the cryptographic material in the DKG... almost certainly should be false
 	SkipKeyVerification bool
+
+	// the number of times a DKG packet is resent to a participant that failed to receive it
+	BroadcastRetries int
+
+	// the length of time between two attempts at sending a DKG packet to a participant. By default, the retries are
+	// spread over a DKG phase so that the packet can still be used by the participant
+	BroadcastRetryInterval time.Duration
+
+	// Entropy, when set, replaces crypto/rand as the source our contribution to the DKG executions is derived from,
+	// making them reproducible. It is only meant for tests and is never set by the daemon: anybody knowing it can
+	// recompute our share of the group secret.
+	Entropy io.Reader
+}
+
+// retransmission returns the policy used to resend the DKG packets participants failed to receive
+func (c *Config) retransmission() retryPolicy {
+	interval := c.BroadcastRetryInterval
+	if interval == 0 && c.BroadcastRetries > 0 {
+		interval = c.TimeBetweenDKGPhases / time.Duration(c.BroadcastRetries+1)
+	}
+	return retryPolicy{retries: c.BroadcastRetries, interval: interval}
 }
 
 type ExecutionOutput struct {
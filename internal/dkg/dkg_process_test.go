@@ -526,6 +526,16 @@ func (p *stubbedDKGProcess) DKGStatus(
 	return p.delegate.DKGStatus(ctx, request)
 }
 
+func (p *stubbedDKGProcess) DKGState(
+	ctx context.Context,
+	request *dkg.DKGStatusRequest,
+	_ ...grpc.CallOption,
+) (*dkg.DKGStateResponse, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.delegate.DKGState(ctx, request)
+}
+
 func (p *stubbedDKGProcess) Command(ctx context.Context, command *dkg.DKGCommand, _ ...grpc.CallOption) (*dkg.EmptyDKGResponse, error) {
 	p.lock.Lock()
 	defer p.lock.Unlock()
@@ -20,9 +20,11 @@ import (
 	"github.com/drand/kyber/sign/schnorr"
 )
 
-func (d *Process) executeDKG(ctx context.Context, beaconID string, executionStartTime time.Time) error {
+// executeDKG sets up and schedules the DKG execution. If resumed is not nil, the execution is resumed from
+// the progress persisted before a restart rather than started anew.
+func (d *Process) executeDKG(ctx context.Context, beaconID string, executionStartTime time.Time, resumed *ExecutionState) error {
 	// set up the DKG broadcaster for first so we're ready to broadcast DKG messages
-	dkgConfig, err := d.setupDKG(ctx, beaconID)
+	dkgConfig, err := d.setupDKG(ctx, beaconID, executionStartTime, resumed)
 	if err != nil {
 		return err
 	}
@@ -36,7 +38,7 @@ func (d *Process) executeDKG(ctx context.Context, beaconID string, executionStar
 		case <-d.close:
 			return
 		case <-time.After(time.Until(executionStartTime)):
-			err := d.executeAndFinishDKG(ctx, beaconID, config)
+			err := d.executeAndFinishDKG(ctx, beaconID, executionStartTime, resumed != nil, config)
 			if err != nil {
 				d.log.Errorw("there was an error during the DKG!", "beaconID", beaconID, "error", err)
 			}
@@ -45,7 +47,7 @@ func (d *Process) executeDKG(ctx context.Context, beaconID string, executionStar
 	return nil
 }
 
-func (d *Process) setupDKG(ctx context.Context, beaconID string) (*dkg.Config, error) {
+func (d *Process) setupDKG(ctx context.Context, beaconID string, startTime time.Time, resumed *ExecutionState) (*dkg.Config, error) {
 	ctx, span := tracer.NewSpan(ctx, "dkg.setupDKG")
 	defer span.End()
 	current, err := d.store.GetCurrent(beaconID)
@@ -78,6 +80,27 @@ func (d *Process) setupDKG(ctx context.Context, beaconID string) (*dkg.Config, e
 		return nil, err
 	}
 
+	// we persist the sealed seed of our contribution and the packets we see, so we can resume after a restart
+	var seed []byte
+	if resumed != nil {
+		seed, err = openSeed(keypair.Key, beaconID, resumed)
+	} else {
+		seed, err = d.newExecution(beaconID, current.Epoch, startTime, keypair.Key)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if seed == nil && d.config.Entropy != nil {
+		// the store can't persist the execution, but we still want our contribution to come from the entropy
+		seed, err = d.newSeed()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if seed != nil {
+		seedConfig(config, seed)
+	}
+
 	// create the network over which to send all the DKG packets
 	board, err := newEchoBroadcast(
 		ctx,
@@ -88,10 +111,17 @@ func (d *Process) setupDKG(ctx context.Context, beaconID string) (*dkg.Config, e
 		sortedParticipants,
 		keypair.Scheme(),
 		config,
+		d.config.retransmission(),
 	)
 	if err != nil {
 		return nil, err
 	}
+	if resumed != nil {
+		if err := board.replay(resumed.Packets); err != nil {
+			return nil, fmt.Errorf("could not replay the persisted DKG packets: %w", err)
+		}
+	}
+	board.persist = d.persistPacket(beaconID)
 
 	// we need some state on the DKG process in order to process any incoming gossip messages from the DKG
 	// if other nodes try to send us DKG messages before this is set we're in trouble
@@ -101,9 +131,11 @@ func (d *Process) setupDKG(ctx context.Context, beaconID string) (*dkg.Config, e
 }
 
 // this is done rarely and is a shared object: no good reason not to use a clone (and it makes the race checker happy)
-func (d *Process) executeAndFinishDKG(ctx context.Context, beaconID string, config *dkg.Config) error {
+func (d *Process) executeAndFinishDKG(ctx context.Context, beaconID string, startTime time.Time, resumed bool, config *dkg.Config) error {
 	ctx, span := tracer.NewSpan(ctx, "dkg.executeAndFinishDKG")
 	defer span.End()
+	// whatever the outcome, the execution can't be resumed once it's over
+	defer d.clearExecution(beaconID)
 
 	current, err := d.store.GetCurrent(beaconID)
 	if err != nil {
@@ -115,7 +147,7 @@ func (d *Process) executeAndFinishDKG(ctx context.Context, beaconID string, conf
 		return err
 	}
 
-	output, err := d.startDKGExecution(ctx, beaconID, current, config)
+	output, err := d.startDKGExecution(ctx, beaconID, startTime, resumed, current, config)
 	if err != nil {
 		dkgErr := err
 		d.log.Errorw("DKG failed. Storing failed state")
@@ -174,21 +206,36 @@ func (d *Process) executeAndFinishDKG(ctx context.Context, beaconID string, conf
 func (d *Process) startDKGExecution(
 	ctx context.Context,
 	beaconID string,
+	startTime time.Time,
+	resumed bool,
 	current *DBState,
 	config *dkg.Config,
 ) (*ExecutionOutput, error) {
 	ctx, span := tracer.NewSpan(ctx, "dkg.startDKGExecution")
 	defer span.End()
-	phaser := dkg.NewTimePhaser(d.config.TimeBetweenDKGPhases)
-	go phaser.Start()
 
-	// NewProtocol actually _starts_ the protocol on a goroutine also
 	d.lock.Lock()
 	broadcaster := d.Executions[beaconID]
 	d.lock.Unlock()
 
+	var board dkg.Board = broadcaster
+	var phaser *dkg.TimePhaser
+	if b, ok := broadcaster.(*echoBroadcast); ok && resumed {
+		// a resumed execution must process the packets seen before the restart before moving between phases,
+		// which it does at the same time as the rest of the group
+		replayCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		replay := newReplayBoard(replayCtx, b)
+		board = replay
+		phaser = newResumedPhaser(startTime, d.config.TimeBetweenDKGPhases, replay.replayed)
+	} else {
+		phaser = dkg.NewTimePhaser(d.config.TimeBetweenDKGPhases)
+	}
+	go phaser.Start()
+
+	// NewProtocol actually _starts_ the protocol on a goroutine also
 	d.log.Info("Starting DKG protocol")
-	protocol, err := dkg.NewProtocol(config, broadcaster, phaser, d.config.SkipKeyVerification)
+	protocol, err := dkg.NewProtocol(config, board, phaser, d.config.SkipKeyVerification)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,420 @@
+package dkg
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/hkdf"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/drand/drand/v2/common/tracer"
+	pdkg "github.com/drand/drand/v2/protobuf/dkg"
+	"github.com/drand/kyber"
+	"github.com/drand/kyber/share/dkg"
+	"github.com/drand/kyber/xof/blake2xb"
+)
+
+// executionSeedLength is the size of the seed our contribution to a DKG execution is derived from
+const executionSeedLength = 32
+
+// executionSeedInfo separates the key sealing the execution seeds from other uses of the private key of the node
+var executionSeedInfo = []byte("drand dkg execution seed v1")
+
+// ExecutionStore is implemented by stores able to persist the progress of an ongoing DKG execution, so that
+// a node restarting in the middle of it can rejoin the execution rather than requiring a new proposal
+type ExecutionStore interface {
+	// SaveExecution stores the details of a DKG execution that is starting, replacing any previous execution
+	SaveExecution(beaconID string, execution *ExecutionState) error
+
+	// SaveExecutionPacket records a DKG packet seen during the ongoing execution
+	SaveExecutionPacket(beaconID string, packet ExecutionPacket) error
+
+	// GetExecution returns the ongoing DKG execution and the packets recorded for it, or nil if there is none
+	GetExecution(beaconID string) (*ExecutionState, error)
+
+	// ClearExecution removes the DKG execution stored for the given beacon, if any
+	ClearExecution(beaconID string) error
+}
+
+// ExecutionState is the progress of a DKG execution persisted so that it can be resumed after a restart
+type ExecutionState struct {
+	Epoch     uint32
+	StartTime time.Time
+	// SealedSeed is the secret our contribution to the DKG is derived from, encrypted by sealSeed: a resumed
+	// execution must deal the same secret polynomial as the one committed to before the restart
+	SealedSeed []byte
+	// Packets are the DKG packets seen during the execution, in the order they were seen
+	Packets []ExecutionPacket
+}
+
+// ExecutionPacket is a protobuf encoded DKG packet seen during an execution
+type ExecutionPacket struct {
+	// Own is true for the packets this node sent out
+	Own  bool
+	Data []byte
+}
+
+// ExecutionStateTOML is the serialized form of the ExecutionState, without its packets which are stored apart
+type ExecutionStateTOML struct {
+	Epoch      uint32
+	StartTime  time.Time
+	SealedSeed string
+}
+
+func (e *ExecutionState) TOML() ExecutionStateTOML {
+	return ExecutionStateTOML{
+		Epoch:      e.Epoch,
+		StartTime:  e.StartTime,
+		SealedSeed: hex.EncodeToString(e.SealedSeed),
+	}
+}
+
+func (e *ExecutionStateTOML) FromTOML() (*ExecutionState, error) {
+	seed, err := hex.DecodeString(e.SealedSeed)
+	if err != nil {
+		return nil, fmt.Errorf("invalid DKG execution seed: %w", err)
+	}
+	return &ExecutionState{
+		Epoch:      e.Epoch,
+		StartTime:  e.StartTime,
+		SealedSeed: seed,
+	}, nil
+}
+
+// ResumeExecution rejoins the DKG execution of the given beacon if the node was restarted while it was
+// running, using the progress persisted before the restart. Packets broadcast by the other nodes while this
+// node was down are not sent again, so the execution only succeeds if the group can still complete it.
+// It does nothing if there is no execution in progress.
+func (d *Process) ResumeExecution(ctx context.Context, beaconID string) error {
+	ctx, span := tracer.NewSpan(ctx, "dkg.ResumeExecution")
+	defer span.End()
+
+	store, ok := d.store.(ExecutionStore)
+	if !ok {
+		return nil
+	}
+
+	current, err := d.store.GetCurrent(beaconID)
+	if err != nil {
+		return err
+	}
+	if current.State != Executing {
+		return nil
+	}
+
+	execution, err := store.GetExecution(beaconID)
+	if err != nil {
+		return err
+	}
+	if execution == nil || execution.Epoch != current.Epoch {
+		d.log.Warnw("restarted during a DKG execution without progress to resume it", "beaconID", beaconID, "epoch", current.Epoch)
+		return nil
+	}
+
+	// once the justification phase is over, the rest of the group has finished the execution without us
+	lastPhase := execution.StartTime.Add(time.Duration(dkg.JustifPhase) * d.config.TimeBetweenDKGPhases)
+	if now := time.Now(); now.After(current.Timeout) || now.After(lastPhase) {
+		d.log.Warnw("too late to resume the DKG execution interrupted by the restart", "beaconID", beaconID, "epoch", current.Epoch)
+		return store.ClearExecution(beaconID)
+	}
+
+	d.log.Infow("resuming the DKG execution interrupted by the restart",
+		"beaconID", beaconID,
+		"epoch", current.Epoch,
+		"packets", len(execution.Packets),
+	)
+	return d.executeDKG(ctx, beaconID, execution.StartTime, execution)
+}
+
+// newExecution persists the state of a DKG execution that is starting, if the store supports it, and returns the
+// seed our contribution to it is derived from. It returns a nil seed if the store can't persist the execution.
+func (d *Process) newExecution(beaconID string, epoch uint32, startTime time.Time, priv kyber.Scalar) ([]byte, error) {
+	store, ok := d.store.(ExecutionStore)
+	if !ok {
+		return nil, nil
+	}
+
+	seed, err := d.newSeed()
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := sealSeed(priv, beaconID, epoch, seed)
+	if err != nil {
+		return nil, err
+	}
+	execution := &ExecutionState{
+		Epoch:      epoch,
+		StartTime:  startTime,
+		SealedSeed: sealed,
+	}
+	if err := store.SaveExecution(beaconID, execution); err != nil {
+		return nil, fmt.Errorf("could not persist the DKG execution: %w", err)
+	}
+	return seed, nil
+}
+
+// newSeed returns a new seed for our contribution to a DKG execution, read from the configured entropy if any
+func (d *Process) newSeed() ([]byte, error) {
+	entropy := rand.Reader
+	if d.config.Entropy != nil {
+		d.log.Warnw("deriving the DKG contribution from the configured entropy, this must never happen in production")
+		entropy = d.config.Entropy
+	}
+	seed := make([]byte, executionSeedLength)
+	if _, err := io.ReadFull(entropy, seed); err != nil {
+		return nil, err
+	}
+	return seed, nil
+}
+
+// persistPacket returns the function recording the packets seen by the broadcast of the given beacon's
+// execution, or nil if the store does not support it
+func (d *Process) persistPacket(beaconID string) func(*pdkg.Packet, bool) {
+	store, ok := d.store.(ExecutionStore)
+	if !ok {
+		return nil
+	}
+	return func(p *pdkg.Packet, own bool) {
+		data, err := proto.Marshal(p)
+		if err == nil {
+			err = store.SaveExecutionPacket(beaconID, ExecutionPacket{Own: own, Data: data})
+		}
+		if err != nil {
+			d.log.Errorw("could not persist DKG packet, the execution will not be resumable", "beaconID", beaconID, "err", err)
+		}
+	}
+}
+
+func (d *Process) clearExecution(beaconID string) {
+	store, ok := d.store.(ExecutionStore)
+	if !ok {
+		return
+	}
+	if err := store.ClearExecution(beaconID); err != nil {
+		d.log.Errorw("could not clear the DKG execution", "beaconID", beaconID, "err", err)
+	}
+}
+
+// sealSeed encrypts the seed of our contribution to a DKG execution before it is persisted, with a key derived
+// from the private key of the node. The seed determines our whole secret polynomial, so it must not be readable by
+// whoever gets hold of the DKG database alone, e.g. from a backup or a copy of the folder of the node. Sealing
+// doesn't protect it from someone able to read the key pair of the node too, who could impersonate the node
+// anyway. The beacon ID and epoch are authenticated, so a sealed seed can't be replayed in another execution.
+func sealSeed(priv kyber.Scalar, beaconID string, epoch uint32, seed []byte) ([]byte, error) {
+	aead, err := seedCipher(priv)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, seed, seedAdditionalData(beaconID, epoch)), nil
+}
+
+// openSeed decrypts the seed of the given execution, sealed by sealSeed
+func openSeed(priv kyber.Scalar, beaconID string, execution *ExecutionState) ([]byte, error) {
+	aead, err := seedCipher(priv)
+	if err != nil {
+		return nil, err
+	}
+	sealed := execution.SealedSeed
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("invalid sealed DKG execution seed")
+	}
+	seed, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], seedAdditionalData(beaconID, execution.Epoch))
+	if err != nil {
+		return nil, fmt.Errorf("could not open the DKG execution seed: %w", err)
+	}
+	return seed, nil
+}
+
+func seedCipher(priv kyber.Scalar) (cipher.AEAD, error) {
+	secret, err := priv.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	key := make([]byte, chacha20poly1305.KeySize)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, secret, nil, executionSeedInfo), key); err != nil {
+		return nil, err
+	}
+	return chacha20poly1305.New(key)
+}
+
+func seedAdditionalData(beaconID string, epoch uint32) []byte {
+	return binary.BigEndian.AppendUint32([]byte(beaconID), epoch)
+}
+
+// seededSuite is a DKG suite whose random stream is derived from the execution seed
+type seededSuite struct {
+	dkg.Suite
+	stream cipher.Stream
+}
+
+func (s *seededSuite) RandomStream() cipher.Stream {
+	return s.stream
+}
+
+// seedConfig derives the randomness of our contribution to the DKG from the execution seed, so that a node
+// restarting mid-DKG deals the same secret polynomial as the commitments it already sent out.
+// Using the seed as the only source of randomness is safe as long as it is itself uniformly random and
+// kept secret, which is why it is only persisted sealed and removed from the store once the execution is over.
+func seedConfig(config *dkg.Config, seed []byte) {
+	config.Suite = &seededSuite{
+		Suite:  config.Suite,
+		stream: blake2xb.New(append([]byte("drand-dkg-polynomial"), seed...)),
+	}
+	config.Reader = blake2xb.New(append([]byte("drand-dkg-secret"), seed...))
+	config.UserReaderOnly = true
+}
+
+// newResumedPhaser returns the phaser of a resumed execution. It moves between phases at fixed offsets from the
+// start of the execution, as the rest of the group did while this node was down, and only once the protocol has
+// taken the packets replayed from before the restart.
+func newResumedPhaser(startTime time.Time, timeBetweenPhases time.Duration, replayed <-chan struct{}) *dkg.TimePhaser {
+	return dkg.NewTimePhaserFunc(func(phase dkg.Phase) {
+		<-replayed
+		time.Sleep(time.Until(startTime.Add(time.Duration(phase) * timeBetweenPhases)))
+	})
+}
+
+// replay records the packets persisted before a restart, to be handed over to the DKG protocol by a replayBoard
+// once it runs. Our own packets are kept aside to be sent out again in place of the ones the protocol generates
+// anew: the other nodes have already accepted them and would evict us for sending different ones.
+func (b *echoBroadcast) replay(packets []ExecutionPacket) error {
+	b.Lock()
+	defer b.Unlock()
+
+	for _, p := range packets {
+		pp := new(pdkg.Packet)
+		if err := proto.Unmarshal(p.Data, pp); err != nil {
+			return err
+		}
+		dkgPacket, err := protoToDKGPacket(pp, b.scheme)
+		if err != nil {
+			return err
+		}
+
+		h := hash(dkgPacket.Hash())
+		if b.hashes.exists(h) {
+			continue
+		}
+		b.hashes.put(h)
+
+		if p.Own {
+			b.resumed[fmt.Sprintf("%T", dkgPacket)] = dkgPacket
+			continue
+		}
+		b.replayed = append(b.replayed, dkgPacket)
+	}
+	return nil
+}
+
+// resumedPacket returns our own packet of the same kind as p sent out before a restart, or p if there is none
+func (b *echoBroadcast) resumedPacket(p packet) packet {
+	b.Lock()
+	defer b.Unlock()
+
+	if prev, ok := b.resumed[fmt.Sprintf("%T", p)]; ok {
+		return prev
+	}
+	return p
+}
+
+// replayBoard is the board a resumed execution runs the protocol over. It hands the packets seen before the
+// restart over to the protocol before the ones received since. Its channels are unbuffered, so that once
+// replayed is closed, the protocol has taken every replayed packet.
+type replayBoard struct {
+	*echoBroadcast
+	deals    chan dkg.DealBundle
+	resps    chan dkg.ResponseBundle
+	justs    chan dkg.JustificationBundle
+	replayed chan struct{}
+}
+
+// newReplayBoard returns the board replaying the packets recorded by b.replay, until the context is done
+func newReplayBoard(ctx context.Context, b *echoBroadcast) *replayBoard {
+	b.Lock()
+	var deals []dkg.DealBundle
+	var resps []dkg.ResponseBundle
+	var justs []dkg.JustificationBundle
+	for _, p := range b.replayed {
+		switch pp := p.(type) {
+		case *dkg.DealBundle:
+			deals = append(deals, *pp)
+		case *dkg.ResponseBundle:
+			resps = append(resps, *pp)
+		case *dkg.JustificationBundle:
+			justs = append(justs, *pp)
+		}
+	}
+	b.replayed = nil
+	b.Unlock()
+
+	r := &replayBoard{
+		echoBroadcast: b,
+		deals:         make(chan dkg.DealBundle),
+		resps:         make(chan dkg.ResponseBundle),
+		justs:         make(chan dkg.JustificationBundle),
+		replayed:      make(chan struct{}),
+	}
+	var wg sync.WaitGroup
+	wg.Add(3) //nolint:mnd // one per kind of packet
+	go forwardPackets(ctx, &wg, deals, b.dealCh, r.deals)
+	go forwardPackets(ctx, &wg, resps, b.respCh, r.resps)
+	go forwardPackets(ctx, &wg, justs, b.justCh, r.justs)
+	go func() {
+		wg.Wait()
+		close(r.replayed)
+	}()
+	return r
+}
+
+func (r *replayBoard) IncomingDeal() <-chan dkg.DealBundle {
+	return r.deals
+}
+
+func (r *replayBoard) IncomingResponse() <-chan dkg.ResponseBundle {
+	return r.resps
+}
+
+func (r *replayBoard) IncomingJustification() <-chan dkg.JustificationBundle {
+	return r.justs
+}
+
+// forwardPackets hands the replayed packets over to out, marks them done, then forwards the packets received on
+// in, until the context is done
+func forwardPackets[T any](ctx context.Context, replayed *sync.WaitGroup, packets []T, in <-chan T, out chan<- T) {
+	for _, p := range packets {
+		select {
+		case out <- p:
+		case <-ctx.Done():
+			replayed.Done()
+			return
+		}
+	}
+	replayed.Done()
+
+	for {
+		select {
+		case p := <-in:
+			select {
+			case out <- p:
+			case <-ctx.Done():
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
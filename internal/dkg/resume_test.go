@@ -0,0 +1,116 @@
+package dkg
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/v2/common/key"
+	"github.com/drand/drand/v2/common/log"
+	"github.com/drand/drand/v2/crypto"
+	"github.com/drand/kyber/share/dkg"
+	"github.com/drand/kyber/sign/schnorr"
+	"github.com/drand/kyber/xof/blake2xb"
+)
+
+func TestSeededConfigDealsSamePolynomial(t *testing.T) {
+	sch, err := crypto.GetSchemeFromEnv()
+	require.NoError(t, err)
+	suite := sch.KeyGroup.(dkg.Suite)
+
+	pairs := make([]*key.Pair, 3)
+	nodes := make([]dkg.Node, len(pairs))
+	for i := range pairs {
+		pairs[i], err = key.NewKeyPair(fmt.Sprintf("127.0.0.1:%d", 8000+i), sch)
+		require.NoError(t, err)
+		nodes[i] = dkg.Node{Index: uint32(i), Public: pairs[i].Public.Key}
+	}
+
+	deal := func(seed []byte) *dkg.DealBundle {
+		nonce := sha256.Sum256([]byte("nonce"))
+		config := &dkg.Config{
+			Suite:     suite,
+			Longterm:  pairs[0].Key,
+			NewNodes:  nodes,
+			Threshold: 2,
+			FastSync:  true,
+			Nonce:     nonce[:],
+			Auth:      schnorr.NewScheme(suite),
+		}
+		seedConfig(config, seed)
+		handler, err := dkg.NewDistKeyHandler(config)
+		require.NoError(t, err)
+		bundle, err := handler.Deals()
+		require.NoError(t, err)
+		return bundle
+	}
+
+	// a restarted node must commit to the same polynomial as before the restart
+	first := deal([]byte("seed"))
+	restarted := deal([]byte("seed"))
+	require.Equal(t, len(first.Public), len(restarted.Public))
+	for i := range first.Public {
+		require.True(t, first.Public[i].Equal(restarted.Public[i]))
+	}
+
+	other := deal([]byte("another seed"))
+	require.False(t, first.Public[0].Equal(other.Public[0]))
+}
+
+func TestSeedFromEntropy(t *testing.T) {
+	newProcess := func(entropy io.Reader) *Process {
+		return &Process{
+			log:    log.New(nil, log.DebugLevel, true),
+			config: Config{Entropy: entropy},
+		}
+	}
+
+	// the same entropy makes for the same contribution to the DKG
+	first, err := newProcess(blake2xb.New([]byte("entropy"))).newSeed()
+	require.NoError(t, err)
+	again, err := newProcess(blake2xb.New([]byte("entropy"))).newSeed()
+	require.NoError(t, err)
+	require.Len(t, first, executionSeedLength)
+	require.Equal(t, first, again)
+
+	// by default the seed comes from crypto/rand
+	random, err := newProcess(nil).newSeed()
+	require.NoError(t, err)
+	require.Len(t, random, executionSeedLength)
+	require.NotEqual(t, first, random)
+
+	// an exhausted entropy source is an error rather than a weak seed
+	_, err = newProcess(bytes.NewReader([]byte("short"))).newSeed()
+	require.Error(t, err)
+}
+
+func TestSealedSeed(t *testing.T) {
+	sch, err := crypto.GetSchemeFromEnv()
+	require.NoError(t, err)
+	pair, err := key.NewKeyPair("127.0.0.1:8000", sch)
+	require.NoError(t, err)
+
+	seed := []byte("a secret seed of thirty-two byte")
+	sealed, err := sealSeed(pair.Key, "default", 2, seed)
+	require.NoError(t, err)
+	// the seed isn't readable from what is persisted
+	require.False(t, bytes.Contains(sealed, seed))
+
+	opened, err := openSeed(pair.Key, "default", &ExecutionState{Epoch: 2, SealedSeed: sealed})
+	require.NoError(t, err)
+	require.Equal(t, seed, opened)
+
+	// it only opens with the key of the node, for the execution it was sealed for
+	other, err := key.NewKeyPair("127.0.0.1:8001", sch)
+	require.NoError(t, err)
+	_, err = openSeed(other.Key, "default", &ExecutionState{Epoch: 2, SealedSeed: sealed})
+	require.Error(t, err)
+	_, err = openSeed(pair.Key, "default", &ExecutionState{Epoch: 3, SealedSeed: sealed})
+	require.Error(t, err)
+	_, err = openSeed(pair.Key, "other", &ExecutionState{Epoch: 2, SealedSeed: sealed})
+	require.Error(t, err)
+}
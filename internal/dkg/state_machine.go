@@ -11,6 +11,9 @@ import (
 	"reflect"
 	"time"
 
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/drand/drand/v2/common"
 	"github.com/drand/drand/v2/common/key"
 	"github.com/drand/drand/v2/crypto"
 	"github.com/drand/drand/v2/internal/util"
@@ -261,6 +264,78 @@ func (d *DBStateTOML) FromTOML() (*DBState, error) {
 	}, nil
 }
 
+// ToProto converts the state to the message the daemon serves it as on its control port. The key share is left
+// out, it must never leave the node.
+func (d *DBState) ToProto() *drand.DKGStateResponse {
+	out := &drand.DKGStateResponse{
+		BeaconID:             d.BeaconID,
+		Epoch:                d.Epoch,
+		State:                uint32(d.State),
+		Threshold:            d.Threshold,
+		SchemeID:             d.SchemeID,
+		GenesisSeed:          d.GenesisSeed,
+		CatchupPeriodSeconds: uint32(d.CatchupPeriod.Seconds()),
+		BeaconPeriodSeconds:  uint32(d.BeaconPeriod.Seconds()),
+		Leader:               d.Leader,
+		Remaining:            d.Remaining,
+		Joining:              d.Joining,
+		Leaving:              d.Leaving,
+		Acceptors:            d.Acceptors,
+		Rejectors:            d.Rejectors,
+	}
+	if !d.Timeout.IsZero() {
+		out.Timeout = timestamppb.New(d.Timeout)
+	}
+	if !d.GenesisTime.IsZero() {
+		out.GenesisTime = timestamppb.New(d.GenesisTime)
+	}
+	if d.FinalGroup != nil {
+		out.FinalGroup = d.FinalGroup.ToProto(common.GetAppVersion())
+	}
+	return out
+}
+
+// StateFromProto converts a state served by a daemon back, without its key share
+func StateFromProto(p *drand.DKGStateResponse) (*DBState, error) {
+	var finalGroup *key.Group
+	if p.GetFinalGroup() != nil {
+		sch, err := crypto.GetSchemeByID(p.GetSchemeID())
+		if err != nil {
+			return nil, err
+		}
+		finalGroup, err = key.GroupFromProto(p.GetFinalGroup(), sch)
+		if err != nil {
+			return nil, err
+		}
+	}
+	fromTimestamp := func(t *timestamppb.Timestamp) time.Time {
+		if t == nil {
+			return time.Time{}
+		}
+		return t.AsTime()
+	}
+
+	return &DBState{
+		BeaconID:      p.GetBeaconID(),
+		Epoch:         p.GetEpoch(),
+		State:         Status(p.GetState()),
+		Threshold:     p.GetThreshold(),
+		Timeout:       fromTimestamp(p.GetTimeout()),
+		SchemeID:      p.GetSchemeID(),
+		GenesisTime:   fromTimestamp(p.GetGenesisTime()),
+		GenesisSeed:   p.GetGenesisSeed(),
+		CatchupPeriod: time.Duration(p.GetCatchupPeriodSeconds()) * time.Second,
+		BeaconPeriod:  time.Duration(p.GetBeaconPeriodSeconds()) * time.Second,
+		Leader:        p.GetLeader(),
+		Remaining:     p.GetRemaining(),
+		Joining:       p.GetJoining(),
+		Leaving:       p.GetLeaving(),
+		Acceptors:     p.GetAcceptors(),
+		Rejectors:     p.GetRejectors(),
+		FinalGroup:    finalGroup,
+	}, nil
+}
+
 func NewFreshState(beaconID string) *DBState {
 	return &DBState{
 		BeaconID: beaconID,
@@ -643,6 +718,7 @@ var ErrMissingNodesInProposal = errors.New("some node(s) in the current epoch ar
 var ErrCannotProposeAsNonLeader = errors.New("cannot make a proposal where you are not the leader")
 var ErrThresholdHigherThanNodeCount = errors.New("the threshold cannot be higher than the count of remaining + joining nodes")
 var ErrNodeCountTooLow = errors.New("the new node count cannot be lower than the prior threshold")
+var ErrCatchupPeriodTooLong = errors.New("the catchup period cannot be longer than the beacon period")
 var ErrThresholdTooLow = errors.New("the threshold is below the minimum required to allow effective secret recovery given the node count")
 var ErrRemainingAndLeavingNodesMustExistInCurrentEpoch = errors.New("remaining and leaving nodes contained a node that does not exist in the current epoch - they must be added as joiners")
 var ErrCannotAcceptProposalWhereLeaving = errors.New("you cannot accept a proposal where your node is leaving")
@@ -762,6 +838,11 @@ func validateForAllDKGs(currentState *DBState, terms *drand.ProposalTerms) error
 		return ErrThresholdTooLow
 	}
 
+	// each proposal sets its own catchup period, a zero one meaning catching up as fast as possible
+	if terms.CatchupPeriodSeconds > terms.BeaconPeriodSeconds {
+		return ErrCatchupPeriodTooLong
+	}
+
 	return validateEpoch(currentState, terms)
 }
 
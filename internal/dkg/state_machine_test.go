@@ -327,6 +327,26 @@ func TestProposalValidation(t *testing.T) {
 			}(),
 			expected: ErrGenesisTimeNotEqual,
 		},
+		{
+			name:  "catchup period longer than the beacon period returns an error",
+			state: NewCompleteDKGEntry(t, beaconID, Complete, alice, bob),
+			terms: func() *drand.ProposalTerms {
+				p := NewValidProposal(beaconID, 2, alice, bob)
+				p.CatchupPeriodSeconds = p.BeaconPeriodSeconds + 1
+				return p
+			}(),
+			expected: ErrCatchupPeriodTooLong,
+		},
+		{
+			name:  "catchup period can change at each reshare, up to the beacon period",
+			state: NewCompleteDKGEntry(t, beaconID, Complete, alice, bob),
+			terms: func() *drand.ProposalTerms {
+				p := NewValidProposal(beaconID, 2, alice, bob)
+				p.CatchupPeriodSeconds = p.BeaconPeriodSeconds
+				return p
+			}(),
+			expected: nil,
+		},
 		{
 			name:  "for the first epoch, genesis seed cannot be provided",
 			state: NewFreshState(beaconID),
@@ -745,6 +765,36 @@ func TestProposingDKGFromNonFresh(t *testing.T) {
 			}(),
 			expectedError: nil,
 		},
+		{
+			name:          "Proposing a reshare with a new catchup period overrides the current one",
+			startingState: NewCompleteDKGEntry(t, beaconID, Complete, alice, bob),
+			transitionFn: func(in *DBState) (*DBState, error) {
+				proposal := NewValidProposal(beaconID, 2, alice, bob)
+				proposal.CatchupPeriodSeconds = 1
+				return in.Proposing(alice, proposal)
+			},
+			expectedResult: func() *DBState {
+				proposal := NewValidProposal(beaconID, 2, alice, bob)
+				return &DBState{
+					BeaconID:      beaconID,
+					Epoch:         2,
+					State:         Proposing,
+					Threshold:     proposal.Threshold,
+					SchemeID:      proposal.SchemeID,
+					GenesisTime:   proposal.GenesisTime.AsTime(),
+					GenesisSeed:   proposal.GenesisSeed,
+					CatchupPeriod: 1 * time.Second,
+					BeaconPeriod:  time.Duration(proposal.BeaconPeriodSeconds) * time.Second,
+					Timeout:       proposal.Timeout.AsTime(),
+					Leader:        alice,
+					Remaining:     proposal.Remaining,
+					Joining:       nil,
+					Leaving:       nil,
+					FinalGroup:    nil,
+				}
+			}(),
+			expectedError: nil,
+		},
 		{
 			name:          "Proposing a valid DKG from Aborted changes state to Proposing",
 			startingState: NewCompleteDKGEntry(t, beaconID, Aborted, alice, bob),
@@ -2,6 +2,7 @@ package dkg
 
 import (
 	bytes2 "bytes"
+	"encoding/binary"
 	"os"
 	"path"
 	"sync"
@@ -29,6 +30,9 @@ const DirPerm = 0755
 
 var stagedStateBucket = []byte("dkg")
 var finishedStateBucket = []byte("dkg_finished")
+var executionBucket = []byte("dkg_execution")
+var executionPacketsBucket = []byte("packets")
+var executionStateKey = []byte("state")
 
 func NewDKGStore(baseFolder string) (*BoltStore, error) {
 	err := os.MkdirAll(baseFolder, DirPerm)
@@ -48,6 +52,11 @@ func NewDKGStore(baseFolder string) (*BoltStore, error) {
 		}
 
 		_, err = tx.CreateBucketIfNotExists(finishedStateBucket)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateBucketIfNotExists(executionBucket)
 		return err
 	})
 	if err != nil {
@@ -91,13 +100,7 @@ func (s *BoltStore) get(beaconID string, bucketName []byte) (*DBState, error) {
 		if value == nil {
 			return nil
 		}
-		t := DBStateTOML{}
-		_, err := toml.NewDecoder(bytes2.NewReader(value)).Decode(&t)
-		if err != nil {
-			return err
-		}
-
-		d, err := t.FromTOML()
+		d, err := decodeState(value)
 		if err != nil {
 			return err
 		}
@@ -138,6 +141,7 @@ func (s *BoltStore) SaveFinished(beaconID string, state *DBState) error {
 		return currentBucket.Put(bytesID, b)
 	})
 }
+
 func encodeState(state *DBState) ([]byte, error) {
 	var bytes []byte
 	b := bytes2.NewBuffer(bytes)
@@ -148,6 +152,16 @@ func encodeState(state *DBState) ([]byte, error) {
 	return b.Bytes(), err
 }
 
+// decodeState decodes a state encoded with encodeState
+func decodeState(value []byte) (*DBState, error) {
+	t := DBStateTOML{}
+	_, err := toml.NewDecoder(bytes2.NewReader(value)).Decode(&t)
+	if err != nil {
+		return nil, err
+	}
+	return t.FromTOML()
+}
+
 func (s *BoltStore) save(bucketName []byte, beaconID string, state *DBState) error {
 	return s.db.Update(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(bucketName)
@@ -252,6 +266,113 @@ func (s *BoltStore) NukeState(beaconID string) error {
 			return err
 		}
 
-		return tx.Bucket(finishedStateBucket).Delete([]byte(beaconID))
+		err = tx.Bucket(finishedStateBucket).Delete([]byte(beaconID))
+		if err != nil {
+			return err
+		}
+
+		return deleteExecution(tx, beaconID)
 	})
 }
+
+// SaveExecution stores the details of a DKG execution that is starting, replacing any previous execution
+// and the packets recorded for it
+func (s *BoltStore) SaveExecution(beaconID string, execution *ExecutionState) error {
+	var b bytes2.Buffer
+	if err := toml.NewEncoder(&b).Encode(execution.TOML()); err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if err := deleteExecution(tx, beaconID); err != nil {
+			return err
+		}
+		bucket, err := tx.Bucket(executionBucket).CreateBucket([]byte(beaconID))
+		if err != nil {
+			return err
+		}
+		if _, err := bucket.CreateBucket(executionPacketsBucket); err != nil {
+			return err
+		}
+		return bucket.Put(executionStateKey, b.Bytes())
+	})
+}
+
+// SaveExecutionPacket records a DKG packet seen during the ongoing execution
+func (s *BoltStore) SaveExecutionPacket(beaconID string, packet ExecutionPacket) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(executionBucket).Bucket([]byte(beaconID))
+		if bucket == nil {
+			return errors.Errorf("no DKG execution stored for beacon %s", beaconID)
+		}
+		packets := bucket.Bucket(executionPacketsBucket)
+		seq, err := packets.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		// packets are keyed by sequence number to be replayed in the order they were seen
+		key := make([]byte, 8) //nolint:mnd
+		binary.BigEndian.PutUint64(key, seq)
+		value := make([]byte, 0, len(packet.Data)+1)
+		if packet.Own {
+			value = append(value, 1)
+		} else {
+			value = append(value, 0)
+		}
+		return packets.Put(key, append(value, packet.Data...))
+	})
+}
+
+// GetExecution returns the ongoing DKG execution and the packets recorded for it, or nil if there is none
+func (s *BoltStore) GetExecution(beaconID string) (*ExecutionState, error) {
+	var execution *ExecutionState
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(executionBucket).Bucket([]byte(beaconID))
+		if bucket == nil {
+			return nil
+		}
+		t := ExecutionStateTOML{}
+		if _, err := toml.NewDecoder(bytes2.NewReader(bucket.Get(executionStateKey))).Decode(&t); err != nil {
+			return err
+		}
+		e, err := t.FromTOML()
+		if err != nil {
+			return err
+		}
+
+		err = bucket.Bucket(executionPacketsBucket).ForEach(func(_, v []byte) error {
+			if len(v) == 0 {
+				return errors.New("empty DKG execution packet stored")
+			}
+			e.Packets = append(e.Packets, ExecutionPacket{
+				Own:  v[0] == 1,
+				Data: bytes2.Clone(v[1:]),
+			})
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		execution = e
+		return nil
+	})
+
+	return execution, err
+}
+
+// ClearExecution removes the DKG execution stored for the given beacon, if any
+func (s *BoltStore) ClearExecution(beaconID string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return deleteExecution(tx, beaconID)
+	})
+}
+
+func deleteExecution(tx *bolt.Tx, beaconID string) error {
+	err := tx.Bucket(executionBucket).DeleteBucket([]byte(beaconID))
+	if errors.Is(err, bolt.ErrBucketNotFound) {
+		return nil
+	}
+	return err
+}
@@ -2,8 +2,12 @@ package dkg
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	drand "github.com/drand/drand/v2/protobuf/dkg"
 )
 
 func TestStoredDKGCanBeRetrieved(t *testing.T) {
@@ -146,3 +150,67 @@ func TestDeletingStateDeletesCurrentAndFinished(t *testing.T) {
 	require.NoError(t, err)
 	require.Nil(t, finished)
 }
+
+func TestExecutionCanBeResumedFromStore(t *testing.T) {
+	store, err := NewDKGStore(t.TempDir())
+	require.NoError(t, err)
+
+	beaconID := "myBeaconId"
+	result, err := store.GetExecution(beaconID)
+	require.NoError(t, err)
+	require.Nil(t, result)
+
+	execution := &ExecutionState{
+		Epoch:      2,
+		StartTime:  time.Now().Truncate(time.Second),
+		SealedSeed: []byte("some sealed seed"),
+	}
+	require.NoError(t, store.SaveExecution(beaconID, execution))
+	require.NoError(t, store.SaveExecutionPacket(beaconID, ExecutionPacket{Own: true, Data: []byte("deal")}))
+	require.NoError(t, store.SaveExecutionPacket(beaconID, ExecutionPacket{Own: false, Data: []byte("response")}))
+
+	// packets come back in the order they were saved
+	result, err = store.GetExecution(beaconID)
+	require.NoError(t, err)
+	require.Equal(t, execution.Epoch, result.Epoch)
+	require.True(t, execution.StartTime.Equal(result.StartTime))
+	require.Equal(t, execution.SealedSeed, result.SealedSeed)
+	require.Equal(t, []ExecutionPacket{{Own: true, Data: []byte("deal")}, {Own: false, Data: []byte("response")}}, result.Packets)
+
+	// a new execution replaces the previous one along with its packets
+	execution.Epoch = 3
+	require.NoError(t, store.SaveExecution(beaconID, execution))
+	result, err = store.GetExecution(beaconID)
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), result.Epoch)
+	require.Empty(t, result.Packets)
+
+	require.NoError(t, store.ClearExecution(beaconID))
+	result, err = store.GetExecution(beaconID)
+	require.NoError(t, err)
+	require.Nil(t, result)
+	require.NoError(t, store.ClearExecution(beaconID))
+}
+
+func TestStateSurvivesProtoRoundTrip(t *testing.T) {
+	state := NewCompleteDKGEntry(t, "myBeaconId", Complete, NewParticipant("somebody"), NewParticipant("somebody else"))
+	state.Acceptors = []*drand.Participant{NewParticipant("somebody else")}
+
+	encoded, err := proto.Marshal(state.ToProto())
+	require.NoError(t, err)
+	decoded := new(drand.DKGStateResponse)
+	require.NoError(t, proto.Unmarshal(encoded, decoded))
+	result, err := StateFromProto(decoded)
+	require.NoError(t, err)
+	// the participants went through the wire, so they are compared as messages
+	require.True(t, proto.Equal(state.ToProto(), result.ToProto()))
+	require.True(t, state.FinalGroup.Equal(result.FinalGroup))
+	require.Equal(t, state.Timeout, result.Timeout)
+	require.Equal(t, state.BeaconPeriod, result.BeaconPeriod)
+
+	// a fresh state has no times nor group
+	fresh := NewFreshState("myBeaconId")
+	result, err = StateFromProto(fresh.ToProto())
+	require.NoError(t, err)
+	require.True(t, fresh.Equals(result))
+}
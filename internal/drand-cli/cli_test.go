@@ -3,6 +3,7 @@ package drand
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"os"
@@ -31,6 +32,7 @@ import (
 	"github.com/drand/drand/v2/internal/fs"
 	"github.com/drand/drand/v2/internal/net"
 	"github.com/drand/drand/v2/internal/test"
+	pdkg "github.com/drand/drand/v2/protobuf/dkg"
 	"github.com/drand/kyber"
 	"github.com/drand/kyber/share"
 	"github.com/drand/kyber/share/dkg"
@@ -120,6 +122,153 @@ func TestDeleteBeacon(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestExportBeacons(t *testing.T) {
+	beaconID := test.GetBeaconIDFromEnv()
+	l := testlogger.New(t)
+	ctx := context.Background()
+	sch, err := crypto.GetSchemeFromEnv()
+	require.NoError(t, err)
+	if sch.Name == crypto.DefaultSchemeID {
+		ctx = chain.SetPreviousRequiredOnContext(ctx)
+	}
+	tmp := path.Join(t.TempDir(), "drand")
+
+	conf := core.NewConfig(l, core.WithConfigFolder(tmp))
+	fs.CreateSecureFolder(conf.DBFolder(beaconID))
+	store, err := boltdb.NewBoltStore(ctx, l, conf.DBFolder(beaconID))
+	require.NoError(t, err)
+	// round 0 is the genesis beacon, whose signature the first round is chained to
+	for round := uint64(0); round <= 5; round++ {
+		require.NoError(t, store.Put(ctx, &common.Beacon{
+			Round:     round,
+			Signature: []byte{byte(round)},
+		}))
+	}
+
+	// the database can't be exported while another process holds it
+	args := []string{"drand", "util", "export-beacons", "--folder", tmp, "--id", beaconID}
+	require.ErrorContains(t, CLI().Run(args), "is the daemon running?")
+	require.NoError(t, store.Close())
+
+	var buff bytes.Buffer
+	app := CLI()
+	app.Writer = &buff
+	require.NoError(t, app.Run(append(args, "--from", "2", "--to", "4")))
+	var exported []exportedBeacon
+	require.NoError(t, json.Unmarshal(buff.Bytes(), &exported))
+	require.Len(t, exported, 3)
+	require.Equal(t, uint64(2), exported[0].Round)
+	require.Equal(t, []byte{2}, []byte(exported[0].Signature))
+	require.Equal(t, uint64(4), exported[2].Round)
+
+	out := path.Join(t.TempDir(), "export.csv")
+	require.NoError(t, CLI().Run(append(args, "--format", "csv", "--out", out)))
+	data, err := os.ReadFile(out)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 6)
+	require.Equal(t, "round,randomness,signature,previous_signature", lines[0])
+	require.True(t, strings.HasPrefix(lines[5], "5,"))
+
+	require.ErrorContains(t, CLI().Run(append(args, "--to", "6")), "chain stops at round 5")
+	require.Error(t, CLI().Run(append(args, "--format", "xml")))
+
+	// exporting must not have modified the database
+	store, err = boltdb.NewBoltStore(ctx, l, conf.DBFolder(beaconID))
+	require.NoError(t, err)
+	defer store.Close()
+	last, err := store.Last(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), last.Round)
+}
+
+func TestVerifyDB(t *testing.T) {
+	beaconID := test.GetBeaconIDFromEnv()
+	l := testlogger.New(t)
+	ctx := context.Background()
+	sch, err := crypto.GetSchemeFromEnv()
+	require.NoError(t, err)
+	if sch.Name == crypto.DefaultSchemeID {
+		ctx = chain.SetPreviousRequiredOnContext(ctx)
+	}
+	tmp := path.Join(t.TempDir(), "drand")
+	conf := core.NewConfig(l, core.WithConfigFolder(tmp))
+
+	secret := sch.KeyGroup.Scalar().Pick(random.New())
+	_, group := test.BatchIdentities(t, 3, sch, beaconID)
+	group.PublicKey = &key.DistPublic{Coefficients: []kyber.Point{sch.KeyGroup.Point().Mul(secret, nil)}}
+	require.NoError(t, key.NewFileStore(conf.ConfigFolderMB(), beaconID).SaveGroup(group))
+
+	fs.CreateSecureFolder(conf.DBFolder(beaconID))
+	store, err := boltdb.NewBoltStore(ctx, l, conf.DBFolder(beaconID))
+	require.NoError(t, err)
+	prev := group.GetGenesisSeed()
+	require.NoError(t, store.Put(ctx, &common.Beacon{Round: 0, Signature: prev}))
+	otherSecret := sch.KeyGroup.Scalar().Pick(random.New())
+	for round := uint64(1); round <= 5; round++ {
+		b := &common.Beacon{Round: round}
+		if sch.Name == crypto.DefaultSchemeID {
+			b.PreviousSig = prev
+		}
+		signer := secret
+		// round 3 is corrupted: its signature is well formed, but not made with the group key
+		if round == 3 {
+			signer = otherSecret
+		}
+		b.Signature, err = sch.AuthScheme.Sign(signer, sch.DigestBeacon(b))
+		require.NoError(t, err)
+		require.NoError(t, store.Put(ctx, b))
+		prev = b.Signature
+	}
+	require.NoError(t, store.Close())
+
+	args := []string{"drand", "util", "verify-db", "--folder", tmp, "--id", beaconID}
+	testCommand(t, append(args, "--to", "2"), "0 invalid, 0 missing")
+
+	var buff bytes.Buffer
+	app := CLI()
+	app.Writer = &buff
+	require.ErrorContains(t, app.Run(args), "corrupted")
+	require.Contains(t, buff.String(), "verified rounds 1 to 5: 1 invalid, 0 missing")
+	require.Contains(t, buff.String(), "first bad round: 3")
+}
+
+func TestRoundTiming(t *testing.T) {
+	genesis := int64(1692803367)
+	args := []string{"--period", "3s", "--genesis", strconv.FormatInt(genesis, 10)}
+
+	run := func(cmd ...string) string {
+		var buf bytes.Buffer
+		app := CLI()
+		app.Writer = &buf
+		require.NoError(t, app.Run(append(append([]string{"drand", "util"}, cmd...), args...)))
+		return buf.String()
+	}
+	require.Equal(t, "1\n", run("round-at", "--time", "2023-08-23T15:09:27Z"))
+	// rounds are emitted at the start of their period
+	require.Equal(t, "10\n", run("round-at", "--time", "2023-08-23T15:09:56Z"))
+	require.Equal(t, "11\n", run("round-at", "--time", "2023-08-23T15:09:57Z"))
+	require.Equal(t, "2023-08-23T15:09:57Z\n", run("time-of", "--round", "11"))
+
+	sch, err := crypto.SchemeFromName(crypto.DefaultSchemeID)
+	require.NoError(t, err)
+	period := 3 * time.Second
+	info := &chain2.Info{
+		PublicKey:   sch.KeyGroup.Point().Base(),
+		Period:      period,
+		Scheme:      crypto.DefaultSchemeID,
+		GenesisTime: genesis,
+	}
+	var buf bytes.Buffer
+	require.NoError(t, info.ToJSON(&buf, nil))
+	infoFile := path.Join(t.TempDir(), "info.json")
+	require.NoError(t, os.WriteFile(infoFile, buf.Bytes(), 0o600))
+	testCommand(t, []string{"drand", "util", "time-of", "--round", "11", "--chain-info", infoFile}, "2023-08-23T15:09:57Z")
+
+	require.Error(t, CLI().Run([]string{"drand", "util", "round-at", "--period", "3s"}))
+	require.Error(t, CLI().Run(append([]string{"drand", "util", "round-at", "--chain-info", infoFile}, args...)))
+}
+
 func TestKeySelfSignError(t *testing.T) {
 	beaconID := test.GetBeaconIDFromEnv()
 
@@ -167,6 +316,110 @@ func TestKeyGen(t *testing.T) {
 	require.Nil(t, priv)
 }
 
+func TestSelfTestWithoutDKG(t *testing.T) {
+	beaconID := test.GetBeaconIDFromEnv()
+
+	tmp := path.Join(t.TempDir(), "drand")
+	sch, _ := crypto.GetSchemeFromEnv()
+	args := []string{"drand", "generate-keypair", "--folder", tmp, "--id", beaconID, "--scheme", sch.Name, "127.0.0.1:8081"}
+	require.NoError(t, CLI().Run(args))
+
+	var buff bytes.Buffer
+	app := CLI()
+	app.Writer = &buff
+	args = []string{"drand", "util", "self-test", "--folder", tmp, "--id", beaconID, "--control", test.FreePort()}
+	require.Error(t, app.Run(args))
+
+	out := buff.String()
+	require.Contains(t, out, "[PASS] load and validate the long-term key pair")
+	require.Contains(t, out, "[FAIL] load the group file")
+	require.Contains(t, out, "[FAIL] load the private share")
+	require.Contains(t, out, "[FAIL] open the beacon store")
+	require.NotContains(t, out, "sign and verify a partial beacon")
+
+	// only the bolt store can be opened from disk, the other engines are skipped when the daemon isn't running
+	buff.Reset()
+	args = []string{"drand", "util", "self-test", "--folder", tmp, "--id", beaconID, "--control", test.FreePort(), "--db", "postgres"}
+	require.Error(t, app.Run(args))
+	require.Contains(t, buff.String(), "[SKIP] open the beacon store")
+}
+
+func TestInfoDiff(t *testing.T) {
+	sch, err := crypto.GetSchemeFromEnv()
+	require.NoError(t, err)
+	beaconID := test.GetBeaconIDFromEnv()
+	_, group := test.BatchIdentities(t, 3, sch, beaconID)
+
+	tmp := t.TempDir()
+	writeInfo := func(name string, info *chain2.Info) string {
+		f, err := os.Create(path.Join(tmp, name))
+		require.NoError(t, err)
+		defer f.Close()
+		require.NoError(t, info.ToJSON(f, nil))
+		return f.Name()
+	}
+	info := chain2.NewChainInfo(group)
+	a := writeInfo("a.json", info)
+	same := writeInfo("same.json", info)
+	info.Period *= 2
+	b := writeInfo("b.json", info)
+
+	var buff bytes.Buffer
+	app := CLI()
+	app.Writer = &buff
+	require.NoError(t, app.Run([]string{"drand", "util", "info-diff", a, same}))
+	require.Contains(t, buff.String(), "describe the same chain")
+
+	buff.Reset()
+	require.Error(t, app.Run([]string{"drand", "util", "info-diff", "--json", a, b}))
+	var out infoDiffOutput
+	require.NoError(t, json.Unmarshal(buff.Bytes(), &out))
+	require.False(t, out.Compatible)
+	require.Equal(t, []string{"period", "hash"}, []string{out.Differences[0].Field, out.Differences[1].Field})
+}
+
+func TestVerifyBeacon(t *testing.T) {
+	for _, name := range crypto.ListSchemes() {
+		t.Run(name, func(t *testing.T) {
+			sch, err := crypto.SchemeFromName(name)
+			require.NoError(t, err)
+			secret := sch.KeyGroup.Scalar().Pick(random.New())
+			pub, err := sch.KeyGroup.Point().Mul(secret, nil).MarshalBinary()
+			require.NoError(t, err)
+
+			b := &common.Beacon{Round: 42}
+			if sch.Name == crypto.DefaultSchemeID {
+				b.PreviousSig = []byte("previous signature")
+			}
+			b.Signature, err = sch.AuthScheme.Sign(secret, sch.DigestBeacon(b))
+			require.NoError(t, err)
+
+			verify := func(round uint64) (string, error) {
+				args := []string{"drand", "util", "verify-beacon", "--scheme", name,
+					"--public", hex.EncodeToString(pub),
+					"--round", strconv.FormatUint(round, 10),
+					"--signature", hex.EncodeToString(b.Signature)}
+				if b.PreviousSig != nil {
+					args = append(args, "--previous", hex.EncodeToString(b.PreviousSig))
+				}
+				var buff bytes.Buffer
+				app := CLI()
+				app.Writer = &buff
+				err := app.Run(args)
+				return buff.String(), err
+			}
+
+			out, err := verify(42)
+			require.NoError(t, err)
+			require.Contains(t, out, "round 42: valid")
+
+			out, err = verify(43)
+			require.Error(t, err)
+			require.Contains(t, out, "round 43: invalid")
+		})
+	}
+}
+
 // tests valid commands and then invalid commands
 func TestStartAndStop(t *testing.T) {
 	tmpPath := t.TempDir()
@@ -1024,6 +1277,34 @@ func TestValidPortSelectionSucceedsDuringKeygen(t *testing.T) {
 	require.NoError(t, app.Run(args))
 }
 
+func TestIPv6AddressesDuringKeygen(t *testing.T) {
+	beaconID := test.GetBeaconIDFromEnv()
+	l := testlogger.New(t)
+
+	for _, tc := range []struct {
+		addr, input, expected string
+	}{
+		{addr: "[::1]:8080", expected: "[::1]:8080"},
+		{addr: "[fe80::1%eth0]:8080", expected: "[fe80::1%eth0]:8080"},
+		{addr: "::1", input: "8080\n", expected: "[::1]:8080"},
+		{addr: "[fe80::1%eth0]", input: "8080\n", expected: "[fe80::1%eth0]:8080"},
+		{addr: "drand.example.com", input: "8080\n", expected: "drand.example.com:8080"},
+		{addr: "drand.example.com:http", input: "8080\n", expected: "drand.example.com:8080"},
+	} {
+		t.Run(tc.addr, func(t *testing.T) {
+			tmp := t.TempDir()
+			app := CLI()
+			app.Reader = strings.NewReader(tc.input)
+			require.NoError(t, app.Run([]string{"drand", "generate-keypair", "--folder", tmp, "--id", beaconID, tc.addr}))
+
+			config := core.NewConfig(l, core.WithConfigFolder(tmp))
+			priv, err := key.NewFileStore(config.ConfigFolderMB(), beaconID).LoadKeyPair()
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, priv.Public.Address())
+		})
+	}
+}
+
 type drandInstance struct {
 	path     string
 	ctrlPort string
@@ -1390,6 +1671,132 @@ func TestDKGStatusDoesntBlowUp(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestShowJSON(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode.")
+	}
+
+	l := testlogger.New(t)
+	sch, err := crypto.GetSchemeFromEnv()
+	require.NoError(t, err)
+	beaconID := test.GetBeaconIDFromEnv()
+
+	n := 2
+	instances := genAndLaunchDrandInstances(t, n)
+	for i, inst := range instances {
+		if i == 0 {
+			inst.startInitialDKG(t, l, instances, n, 1, beaconID, sch)
+		} else {
+			inst.join(t, beaconID)
+		}
+	}
+	instances[0].executeDKG(t, beaconID)
+	dkgTimeoutSeconds := 20
+	require.NoError(t, instances[0].awaitDKGComplete(t, beaconID, 1, dkgTimeoutSeconds))
+
+	var buff bytes.Buffer
+	app := CLI()
+	app.Writer = &buff
+	require.NoError(t, app.Run([]string{"drand", "show", "group", "--json", "--control", instances[0].ctrlPort, "--id", beaconID}))
+	var group key.GroupTOML
+	require.NoError(t, json.Unmarshal(buff.Bytes(), &group))
+	require.Equal(t, n, group.Threshold)
+	require.Len(t, group.Nodes, n)
+	require.NotZero(t, group.GenesisTime)
+	require.NotNil(t, group.PublicKey)
+	require.Len(t, group.PublicKey.Coefficients, n)
+	require.Equal(t, sch.Name, group.SchemeID)
+
+	buff.Reset()
+	require.NoError(t, app.Run([]string{"drand", "show", "public", "--json", "--control", instances[0].ctrlPort, "--id", beaconID}))
+	var public key.PublicTOML
+	require.NoError(t, json.Unmarshal(buff.Bytes(), &public))
+	require.Equal(t, instances[0].addr, public.Address)
+	require.NotEmpty(t, public.Key)
+	require.Equal(t, sch.Name, public.SchemeName)
+
+	buff.Reset()
+	require.NoError(t, app.Run([]string{"drand", "show", "chain-info", "--json", "--control", instances[0].ctrlPort, "--id", beaconID}))
+	info, err := chain2.InfoFromJSON(bytes.NewReader(buff.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, sch.Name, info.Scheme)
+
+	// the chain hash is the one of the chain info, not the one of the group file
+	buff.Reset()
+	require.NoError(t, app.Run([]string{"drand", "show", "chain-info", "--hash-only", "--control", instances[0].ctrlPort, "--id", beaconID}))
+	require.Equal(t, info.HashString(), strings.TrimSpace(buff.String()))
+	buff.Reset()
+	require.NoError(t, app.Run([]string{"drand", "show", "group", "--hash", "--control", instances[0].ctrlPort, "--id", beaconID}))
+	require.NotEqual(t, info.HashString(), strings.TrimSpace(buff.String()))
+}
+
+func TestDKGValidateProposal(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode.")
+	}
+
+	l := testlogger.New(t)
+	sch, err := crypto.GetSchemeFromEnv()
+	require.NoError(t, err)
+	beaconID := test.GetBeaconIDFromEnv()
+
+	n := 3
+	threshold := 2
+	instances := genAndLaunchDrandInstances(t, n)
+	for i, inst := range instances {
+		if i == 0 {
+			inst.startInitialDKG(t, l, instances, threshold, 1, beaconID, sch)
+		} else {
+			inst.join(t, beaconID)
+		}
+	}
+	instances[0].executeDKG(t, beaconID)
+	dkgTimeoutSeconds := 20
+	require.NoError(t, instances[0].awaitDKGComplete(t, beaconID, 1, dkgTimeoutSeconds))
+
+	// the daemon sends its whole DKG state, but not its key share
+	dkgClient, err := net.NewDKGControlClient(l, instances[0].ctrlPort)
+	require.NoError(t, err)
+	current, err := dkgClient.DKGState(context.Background(), &pdkg.DKGStatusRequest{BeaconID: beaconID})
+	require.NoError(t, err)
+	state, err := dkg2.StateFromProto(current)
+	require.NoError(t, err)
+	require.Equal(t, dkg2.Complete, state.State)
+	require.Equal(t, uint32(1), state.Epoch)
+	require.Len(t, state.FinalGroup.Nodes, n)
+	require.Equal(t, sch.Name, state.SchemeID)
+	require.Nil(t, state.KeyShare)
+
+	dir := t.TempDir()
+	validProposal := path.Join(dir, "valid.toml")
+	testCommand(t, []string{"drand", "dkg", "generate-proposal", "--control", instances[0].ctrlPort, "--id", beaconID,
+		"--remainer", instances[0].addr, "--remainer", instances[1].addr, "--remainer", instances[2].addr,
+		"--out", validProposal}, "")
+	missingProposal := path.Join(dir, "missing.toml")
+	testCommand(t, []string{"drand", "dkg", "generate-proposal", "--control", instances[0].ctrlPort, "--id", beaconID,
+		"--remainer", instances[0].addr, "--remainer", instances[1].addr, "--out", missingProposal}, "")
+
+	var buff bytes.Buffer
+	app := CLI()
+	app.Writer = &buff
+	require.NoError(t, app.Run([]string{"drand", "dkg", "validate-proposal", "--control", instances[0].ctrlPort,
+		"--id", beaconID, "--threshold", "2", validProposal}))
+	require.Contains(t, buff.String(), "is valid")
+
+	// a threshold of 1 is too low for 3 nodes
+	err = app.Run([]string{"drand", "dkg", "validate-proposal", "--control", instances[0].ctrlPort,
+		"--id", beaconID, "--threshold", "1", validProposal})
+	require.ErrorIs(t, err, dkg2.ErrThresholdTooLow)
+
+	err = app.Run([]string{"drand", "dkg", "validate-proposal", "--control", instances[0].ctrlPort,
+		"--id", beaconID, "--threshold", "2", missingProposal})
+	require.ErrorIs(t, err, dkg2.ErrMissingNodesInProposal)
+	require.Contains(t, err.Error(), instances[2].addr)
+
+	// validating a proposal doesn't start a new DKG
+	require.NoError(t, instances[0].awaitDKGComplete(t, beaconID, 1, 1))
+}
+
 func TestDeleteBeaconNegativeRound(t *testing.T) {
 	beaconID := test.GetBeaconIDFromEnv()
 	l := testlogger.New(t)
@@ -1487,3 +1894,56 @@ func TestMetricsForPeer(t *testing.T) {
 	require.Contains(t, string(out), "outgoing_connection_state")
 	require.GreaterOrEqual(t, len(out), 512)
 }
+
+func TestUtilPeers(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode.")
+	}
+
+	l := testlogger.New(t)
+	sch, err := crypto.GetSchemeFromEnv()
+	require.NoError(t, err)
+	beaconID := test.GetBeaconIDFromEnv()
+
+	n := 3
+	instances := genAndLaunchDrandInstances(t, n)
+	for i, inst := range instances {
+		if i == 0 {
+			inst.startInitialDKG(t, l, instances, 2, 1, beaconID, sch)
+		} else {
+			inst.join(t, beaconID)
+		}
+	}
+	instances[0].executeDKG(t, beaconID)
+	require.NoError(t, instances[0].awaitDKGComplete(t, beaconID, 1, 20))
+	require.NoError(t, instances[2].stopAll())
+
+	peers := func() []peerStatus {
+		var buff bytes.Buffer
+		app := CLI()
+		app.Writer = &buff
+		require.NoError(t, app.Run([]string{"drand", "util", "peers", "--control", instances[0].ctrlPort,
+			"--id", beaconID, "--json"}))
+		var peers []peerStatus
+		require.NoError(t, json.Unmarshal(buff.Bytes(), &peers))
+		return peers
+	}
+
+	byAddr := make(map[string]peerStatus)
+	for _, peer := range peers() {
+		byAddr[peer.Address] = peer
+	}
+	require.Len(t, byAddr, n)
+	for _, inst := range instances[:2] {
+		require.True(t, byAddr[inst.addr].Reachable, inst.addr)
+		require.True(t, byAddr[inst.addr].Replied, inst.addr)
+	}
+	require.False(t, byAddr[instances[2].addr].Reachable)
+	require.False(t, byAddr[instances[2].addr].Replied)
+	require.Nil(t, byAddr[instances[2].addr].Behind)
+
+	testCommand(t, []string{"drand", "util", "peers", "--control", instances[0].ctrlPort, "--id", beaconID},
+		"no reply")
+	testCommand(t, []string{"drand", "util", "peers", "--control", instances[0].ctrlPort, "--id", beaconID},
+		"unknown")
+}
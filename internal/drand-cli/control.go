@@ -17,6 +17,7 @@ import (
 	"github.com/drand/drand/v2/common/chain"
 	"github.com/drand/drand/v2/common/key"
 	"github.com/drand/drand/v2/common/log"
+	"github.com/drand/drand/v2/crypto"
 	"github.com/drand/drand/v2/internal/core"
 	"github.com/drand/drand/v2/internal/net"
 	control "github.com/drand/drand/v2/protobuf/drand"
@@ -244,10 +245,11 @@ func showChainInfo(c *cli.Context, l log.Logger) error {
 		return fmt.Errorf("could not get correct chain info: %w", err)
 	}
 
-	if c.Bool(hashOnly.Name) {
+	if c.Bool(chainHashOnlyFlag.Name) {
 		fmt.Fprintf(c.App.Writer, "%s\n", hex.EncodeToString(ci.Hash()))
 		return nil
 	}
+	// the chain info is always printed in JSON, with its chain hash in its "hash" field
 	return printJSON(c.App.Writer, ci.ToProto(nil))
 }
 
@@ -263,6 +265,22 @@ func showPublicCmd(c *cli.Context, l log.Logger) error {
 		return fmt.Errorf("drand: could not request drand.public: %w", err)
 	}
 
+	if c.Bool(jsonFlag.Name) {
+		sch, err := crypto.SchemeFromName(resp.GetSchemeName())
+		if err != nil {
+			return err
+		}
+		identity, err := key.IdentityFromProto(&control.Identity{
+			Address:   resp.GetAddr(),
+			Key:       resp.GetPubKey(),
+			Signature: resp.GetSignature(),
+		}, sch)
+		if err != nil {
+			return fmt.Errorf("drand: invalid drand.public: %w", err)
+		}
+		// the field names are the ones of the drand_id.public file
+		return printJSON(c.App.Writer, identity.TOML())
+	}
 	return printJSON(c.App.Writer, resp)
 }
 
@@ -61,14 +61,14 @@ func stopDaemon(c *cli.Context, lg log.Logger) error {
 	isBeaconIDSet := c.IsSet(beaconIDFlag.Name)
 	if isBeaconIDSet {
 		beaconID := getBeaconID(c)
-		_, err = ctrlClient.Shutdown(beaconID)
+		_, err = ctrlClient.ShutdownWithTimeout(beaconID, c.Duration(stopTimeoutFlag.Name))
 
 		if err != nil {
 			return fmt.Errorf("error stopping beacon process [%s]: %w", beaconID, err)
 		}
 		fmt.Fprintf(c.App.Writer, "beacon process [%s] stopped correctly. Bye.\n", beaconID)
 	} else {
-		_, err = ctrlClient.Shutdown("")
+		_, err = ctrlClient.ShutdownWithTimeout("", c.Duration(stopTimeoutFlag.Name))
 
 		if err != nil {
 			return fmt.Errorf("error stopping drand daemon: %w", err)
@@ -114,6 +114,23 @@ var dkgCommand = &cli.Command{
 			),
 			Action: abortDKG,
 		},
+		{
+			Name:      "validate-proposal",
+			Usage:     "Checks whether a reshare proposal would be accepted by the current DKG state, without sending it",
+			ArgsUsage: "<proposal.toml>",
+			Flags: toArray(
+				beaconIDFlag,
+				controlFlag,
+				thresholdFlag,
+				catchupPeriodFlag,
+				dkgTimeoutFlag,
+			),
+			Action: func(c *cli.Context) error {
+				l := log.New(nil, logLevel(c), logJSON(c)).
+					Named("dkgValidateProposal")
+				return validateProposalCmd(c, l)
+			},
+		},
 		{
 			Name: "status",
 			Flags: toArray(
@@ -322,12 +339,16 @@ func parseProposal(c *cli.Context) (*drand.ProposalOptions, error) {
 		return nil, fmt.Errorf("%s flag is required ", proposalFlag.Name)
 	}
 
+	return parseProposalFile(c, c.String(proposalFlag.Name))
+}
+
+// parseProposalFile builds the options of a reshare proposal from the proposal file at the given path and the flags
+func parseProposalFile(c *cli.Context, proposalFilePath string) (*drand.ProposalOptions, error) {
 	if !c.IsSet(thresholdFlag.Name) {
 		return nil, fmt.Errorf("%s flag is required", thresholdFlag.Name)
 	}
 
 	// parse a proposal file from the path specified
-	proposalFilePath := c.String(proposalFlag.Name)
 	proposalFile, err := ParseProposalFile(proposalFilePath)
 	if err != nil {
 		return nil, err
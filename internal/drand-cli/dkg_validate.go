@@ -0,0 +1,163 @@
+package drand
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/drand/drand/v2/common"
+	"github.com/drand/drand/v2/common/log"
+	"github.com/drand/drand/v2/internal/core"
+	"github.com/drand/drand/v2/internal/dkg"
+	"github.com/drand/drand/v2/internal/net"
+	drand "github.com/drand/drand/v2/protobuf/dkg"
+	kdkg "github.com/drand/kyber/share/dkg"
+)
+
+// proposalHints explain how to fix the most common reasons for a proposal to be rejected. They are checked in order,
+// so that the hint of the first sentinel an error wraps is given.
+var proposalHints = []struct {
+	err  error
+	hint func(state *dkg.DBState, terms *drand.ProposalTerms) string
+}{
+	{dkg.ErrThresholdTooLow, func(_ *dkg.DBState, terms *drand.ProposalTerms) string {
+		n := len(terms.Joining) + len(terms.Remaining)
+		return fmt.Sprintf("with %d remaining and joining nodes, the threshold must be at least %d", n, kdkg.MinimumT(n))
+	}},
+	{dkg.ErrThresholdHigherThanNodeCount, func(_ *dkg.DBState, terms *drand.ProposalTerms) string {
+		return fmt.Sprintf("the threshold %d is higher than the %d remaining and joining nodes",
+			terms.Threshold, len(terms.Joining)+len(terms.Remaining))
+	}},
+	{dkg.ErrNodeCountTooLow, func(state *dkg.DBState, terms *drand.ProposalTerms) string {
+		return fmt.Sprintf("only %d nodes are remaining but at least %d, the current threshold, must remain to reshare",
+			len(terms.Remaining), state.Threshold)
+	}},
+	{dkg.ErrInvalidEpoch, func(state *dkg.DBState, terms *drand.ProposalTerms) string {
+		return fmt.Sprintf("the current epoch is %d in state %s, a proposal for epoch %d can't follow it",
+			state.Epoch, state.State, terms.Epoch)
+	}},
+	{dkg.ErrMissingNodesInProposal, func(state *dkg.DBState, terms *drand.ProposalTerms) string {
+		return fmt.Sprintf("every node of the current group must be remaining or leaving, missing: %s",
+			strings.Join(missingFromProposal(state, terms), ", "))
+	}},
+	{dkg.ErrRemainingAndLeavingNodesMustExistInCurrentEpoch, func(state *dkg.DBState, terms *drand.ProposalTerms) string {
+		return fmt.Sprintf("nodes that are not part of the current group must be joiners: %s",
+			strings.Join(unknownInProposal(state, terms), ", "))
+	}},
+	{dkg.ErrLeaderNotRemaining, func(_ *dkg.DBState, terms *drand.ProposalTerms) string {
+		return fmt.Sprintf("this node (%s) leads the proposal so it must be one of the remainers", terms.Leader.GetAddress())
+	}},
+	{dkg.ErrCatchupPeriodTooLong, func(_ *dkg.DBState, terms *drand.ProposalTerms) string {
+		return fmt.Sprintf("the beacon period is %ds, use a --catchup-period of at most that", terms.BeaconPeriodSeconds)
+	}},
+	{dkg.ErrTimeoutReached, func(_ *dkg.DBState, _ *drand.ProposalTerms) string {
+		return "the timeout of the proposal is in the past, use a longer --timeout"
+	}},
+}
+
+// validateProposalCmd checks a reshare proposal against the DKG state of the running daemon, the same way the
+// daemon would if it was sent with `drand dkg reshare`, and explains why it would be rejected.
+func validateProposalCmd(c *cli.Context, l log.Logger) error {
+	if c.Args().Len() != 1 {
+		return errors.New("you must pass the path of the proposal file to validate")
+	}
+	beaconID := withDefault(c.String(beaconIDFlag.Name), common.DefaultBeaconID)
+	controlPort := withDefault(c.String(controlFlag.Name), core.DefaultControlPort)
+
+	options, err := parseProposalFile(c, c.Args().First())
+	if err != nil {
+		return err
+	}
+
+	state, me, err := currentDKGState(l, controlPort, beaconID)
+	if err != nil {
+		return err
+	}
+
+	terms := dkg.ReshareTerms(beaconID, me, state, options)
+	if _, err := state.Proposing(me, terms); err != nil {
+		for _, h := range proposalHints {
+			if errors.Is(err, h.err) {
+				return fmt.Errorf("the proposal is invalid: %w\n%s", err, h.hint(state, terms))
+			}
+		}
+		return fmt.Errorf("the proposal is invalid: %w", err)
+	}
+
+	fmt.Fprintf(c.App.Writer, "the proposal for epoch %d of beacon id [%s] is valid\n", terms.Epoch, beaconID)
+	return nil
+}
+
+// currentDKGState fetches the DKG state of the daemon, as it is in its DKG store, along with the participant the
+// daemon would lead a proposal as
+func currentDKGState(l log.Logger, controlPort, beaconID string) (*dkg.DBState, *drand.Participant, error) {
+	dkgClient, err := net.NewDKGControlClient(l, controlPort)
+	if err != nil {
+		return nil, nil, err
+	}
+	current, err := dkgClient.DKGState(context.Background(), &drand.DKGStatusRequest{BeaconID: beaconID})
+	if err != nil {
+		return nil, nil, fmt.Errorf("beacon id [%s] - unable to fetch the DKG state: %w", beaconID, err)
+	}
+	state, err := dkg.StateFromProto(current)
+	if err != nil {
+		return nil, nil, err
+	}
+	if state.State == dkg.Fresh {
+		return nil, nil, fmt.Errorf("beacon id [%s] - no DKG has been run yet, only reshare proposals can be validated", beaconID)
+	}
+
+	client, err := net.NewControlClient(l, controlPort)
+	if err != nil {
+		return nil, nil, err
+	}
+	identity, err := client.PublicKey(beaconID)
+	if err != nil {
+		return nil, nil, err
+	}
+	me := &drand.Participant{
+		Address:   identity.Addr,
+		Key:       identity.PubKey,
+		Signature: identity.Signature,
+	}
+	return state, me, nil
+}
+
+// missingFromProposal returns the addresses of the nodes of the current group that are neither remaining nor leaving
+func missingFromProposal(state *dkg.DBState, terms *drand.ProposalTerms) []string {
+	if state.FinalGroup == nil {
+		return nil
+	}
+	proposed := make(map[string]bool)
+	for _, p := range slices.Concat(terms.Remaining, terms.Leaving) {
+		proposed[p.GetAddress()] = true
+	}
+	var missing []string
+	for _, node := range state.FinalGroup.Nodes {
+		if !proposed[node.Address()] {
+			missing = append(missing, node.Address())
+		}
+	}
+	return missing
+}
+
+// unknownInProposal returns the addresses of the remaining and leaving nodes that are not part of the current group
+func unknownInProposal(state *dkg.DBState, terms *drand.ProposalTerms) []string {
+	current := make(map[string]bool)
+	if state.FinalGroup != nil {
+		for _, node := range state.FinalGroup.Nodes {
+			current[node.Address()] = true
+		}
+	}
+	var unknown []string
+	for _, p := range slices.Concat(terms.Remaining, terms.Leaving) {
+		if !current[p.GetAddress()] {
+			unknown = append(unknown, p.GetAddress())
+		}
+	}
+	return unknown
+}
@@ -0,0 +1,228 @@
+package drand
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	json "github.com/nikkolasg/hexjson"
+	"github.com/urfave/cli/v2"
+
+	"github.com/drand/drand/v2/common"
+	"github.com/drand/drand/v2/common/log"
+	"github.com/drand/drand/v2/crypto"
+	"github.com/drand/drand/v2/internal/chain"
+	"github.com/drand/drand/v2/internal/chain/boltdb"
+	chainerrors "github.com/drand/drand/v2/internal/chain/errors"
+	"github.com/drand/drand/v2/internal/core"
+	"github.com/drand/drand/v2/internal/fs"
+)
+
+var exportFromFlag = &cli.Uint64Flag{
+	Name:  "from",
+	Usage: "the first round to export, 0 being the genesis beacon",
+	Value: 1,
+}
+
+var exportToFlag = &cli.Uint64Flag{
+	Name:  "to",
+	Usage: "the last round to export, the last stored round by default",
+}
+
+var exportFormatFlag = &cli.StringFlag{
+	Name:  "format",
+	Usage: "the format of the export. Valid options are: json, csv",
+	Value: "json",
+}
+
+var exportOutFlag = &cli.StringFlag{
+	Name:  "out",
+	Usage: "the file to write the export to instead of stdout",
+}
+
+// exportedBeacon is the representation of a beacon in an export
+type exportedBeacon struct {
+	Round             uint64          `json:"round"`
+	Randomness        common.HexBytes `json:"randomness"`
+	Signature         common.HexBytes `json:"signature"`
+	PreviousSignature common.HexBytes `json:"previous_signature,omitempty"`
+}
+
+// beaconWriter writes the beacons of an export in a given format
+type beaconWriter interface {
+	Write(b *exportedBeacon) error
+	// Close terminates the export, it doesn't close the underlying writer
+	Close() error
+}
+
+// exportBeaconsCmd dumps a range of rounds from the local beacon database. It opens the database read-only,
+// so it can't run while the daemon holds it.
+func exportBeaconsCmd(c *cli.Context, l log.Logger) error {
+	conf := contextToConfig(c, l)
+	beaconID := getBeaconID(c)
+
+	from, to := c.Uint64(exportFromFlag.Name), c.Uint64(exportToFlag.Name)
+	if c.IsSet(exportToFlag.Name) && to < from {
+		return fmt.Errorf("invalid range: from %d is after to %d", from, to)
+	}
+
+	sch, err := crypto.GetSchemeFromEnv()
+	if err != nil {
+		return err
+	}
+	ctx, store, err := openBeaconDBReadOnly(c.Context, l, conf, beaconID, sch)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	last, err := store.Last(ctx)
+	if err != nil {
+		return fmt.Errorf("beacon id [%s] - can't fetch last beacon: %w", beaconID, err)
+	}
+	if !c.IsSet(exportToFlag.Name) {
+		to = last.Round
+	}
+	if to > last.Round {
+		return fmt.Errorf("beacon id [%s] - requested range ends at round %d but the chain stops at round %d",
+			beaconID, to, last.Round)
+	}
+	if from > to {
+		return fmt.Errorf("beacon id [%s] - requested range starts at round %d but the chain stops at round %d",
+			beaconID, from, last.Round)
+	}
+
+	var out io.Writer = c.App.Writer
+	if c.IsSet(exportOutFlag.Name) {
+		f, err := os.Create(c.String(exportOutFlag.Name))
+		if err != nil {
+			return fmt.Errorf("unable to create the export file: %w", err)
+		}
+		defer f.Close()
+		out = f
+	}
+	buf := bufio.NewWriter(out)
+
+	var w beaconWriter
+	switch format := c.String(exportFormatFlag.Name); format {
+	case "json":
+		w = &jsonBeaconWriter{w: buf}
+	case "csv":
+		w = newCSVBeaconWriter(buf)
+	default:
+		return fmt.Errorf("unsupported export format %q, valid options are: json, csv", format)
+	}
+
+	err = store.Cursor(ctx, func(ctx context.Context, cursor chain.Cursor) error {
+		b, err := cursor.Seek(ctx, from)
+		if err != nil {
+			return fmt.Errorf("unable to get round %d: %w", from, err)
+		}
+		for ; err == nil && b.Round <= to; b, err = cursor.Next(ctx) {
+			if err := w.Write(&exportedBeacon{
+				Round:             b.Round,
+				Randomness:        b.RandomnessFor(sch),
+				Signature:         b.Signature,
+				PreviousSignature: b.PreviousSig,
+			}); err != nil {
+				return err
+			}
+		}
+		// the end of the chain has been reached
+		if errors.Is(err, chainerrors.ErrNoBeaconStored) {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("beacon id [%s] - export failed: %w", beaconID, err)
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return buf.Flush()
+}
+
+// openBeaconDBReadOnly opens the local beacon database of the given beacon read-only. The returned context must be
+// used to access the store.
+func openBeaconDBReadOnly(ctx context.Context, l log.Logger, conf *core.Config, beaconID string, sch *crypto.Scheme,
+) (context.Context, chain.Store, error) {
+	ctx = boltdb.ReadOnly(ctx)
+	if sch.Name == crypto.DefaultSchemeID {
+		ctx = chain.SetPreviousRequiredOnContext(ctx)
+	}
+
+	dbFolder := conf.DBFolder(beaconID)
+	if exists, err := fs.Exists(dbFolder); err != nil || !exists {
+		return nil, nil, fmt.Errorf("beacon id [%s] - no beacon database found in %s", beaconID, dbFolder)
+	}
+	store, err := boltdb.NewBoltStore(ctx, l, dbFolder)
+	if err != nil {
+		return nil, nil, fmt.Errorf("beacon id [%s] - unable to open the beacon database: %w", beaconID, err)
+	}
+	return ctx, store, nil
+}
+
+// jsonBeaconWriter writes the export as a JSON array, one beacon at a time
+type jsonBeaconWriter struct {
+	w     io.Writer
+	count int
+}
+
+func (j *jsonBeaconWriter) Write(b *exportedBeacon) error {
+	data, err := json.Marshal(b)
+	if err != nil {
+		return err
+	}
+	sep := ",\n  "
+	if j.count == 0 {
+		sep = "[\n  "
+	}
+	j.count++
+	if _, err := io.WriteString(j.w, sep); err != nil {
+		return err
+	}
+	_, err = j.w.Write(data)
+	return err
+}
+
+func (j *jsonBeaconWriter) Close() error {
+	end := "\n]\n"
+	if j.count == 0 {
+		end = "[]\n"
+	}
+	_, err := io.WriteString(j.w, end)
+	return err
+}
+
+// csvBeaconWriter writes the export as CSV, with a header row and hex encoded fields
+type csvBeaconWriter struct {
+	w *csv.Writer
+}
+
+func newCSVBeaconWriter(w io.Writer) *csvBeaconWriter {
+	cw := csv.NewWriter(w)
+	// the csv writer is buffered, a failure to write the header is reported when closing it
+	_ = cw.Write([]string{"round", "randomness", "signature", "previous_signature"})
+	return &csvBeaconWriter{w: cw}
+}
+
+func (c *csvBeaconWriter) Write(b *exportedBeacon) error {
+	return c.w.Write([]string{
+		strconv.FormatUint(b.Round, 10), //nolint:mnd // base 10
+		hex.EncodeToString(b.Randomness),
+		hex.EncodeToString(b.Signature),
+		hex.EncodeToString(b.PreviousSignature),
+	})
+}
+
+func (c *csvBeaconWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
@@ -0,0 +1,82 @@
+package drand
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/drand/drand/v2/common/chain"
+	"github.com/drand/drand/v2/common/log"
+)
+
+// infoDiffOutput is the JSON output of the info-diff command
+type infoDiffOutput struct {
+	Compatible  bool                   `json:"compatible"`
+	A           string                 `json:"a"`
+	B           string                 `json:"b"`
+	Differences []chain.InfoDifference `json:"differences"`
+}
+
+// infoDiffCmd shows how the two chain info JSON files given as arguments differ
+func infoDiffCmd(c *cli.Context, _ log.Logger) error {
+	if c.Args().Len() != 2 { //nolint:mnd
+		return errors.New("expecting the paths of the two chain info JSON files to compare")
+	}
+	pathA, pathB := c.Args().Get(0), c.Args().Get(1)
+
+	a, err := readChainInfo(pathA)
+	if err != nil {
+		return err
+	}
+	b, err := readChainInfo(pathB)
+	if err != nil {
+		return err
+	}
+
+	diffs := a.Compatible(b)
+
+	if c.Bool(jsonFlag.Name) {
+		err := printJSON(c.App.Writer, infoDiffOutput{
+			Compatible:  len(diffs) == 0,
+			A:           pathA,
+			B:           pathB,
+			Differences: diffs,
+		})
+		if err != nil {
+			return err
+		}
+	} else if len(diffs) == 0 {
+		fmt.Fprintf(c.App.Writer, "%s and %s describe the same chain (hash %s)\n", pathA, pathB, a.HashString())
+	} else {
+		w := tabwriter.NewWriter(c.App.Writer, 0, 0, 2, ' ', 0) //nolint:mnd
+		fmt.Fprintf(w, "FIELD\t%s\t%s\n", pathA, pathB)
+		for _, d := range diffs {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", d.Field, d.A, d.B)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if len(diffs) > 0 {
+		return fmt.Errorf("chain infos differ on %d field(s)", len(diffs))
+	}
+	return nil
+}
+
+func readChainInfo(filePath string) (*chain.Info, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("can't open chain info %s: %w", filePath, err)
+	}
+	defer f.Close()
+
+	info, err := chain.InfoFromJSON(f)
+	if err != nil {
+		return nil, fmt.Errorf("can't read chain info %s: %w", filePath, err)
+	}
+	return info, nil
+}
@@ -0,0 +1,114 @@
+package drand
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/urfave/cli/v2"
+
+	"github.com/drand/drand/v2/common"
+	"github.com/drand/drand/v2/common/key"
+	"github.com/drand/drand/v2/common/log"
+	control "github.com/drand/drand/v2/protobuf/drand"
+)
+
+// peerStatus is what the local node knows about one of the nodes of its group
+type peerStatus struct {
+	Address string `json:"address"`
+	// Reachable tells whether the local node can ping the peer
+	Reachable bool `json:"reachable"`
+	// Replied tells whether the peer answered the status request of the local node
+	Replied bool `json:"replied"`
+	// LastRound is the latest round stored by the peer, 0 if it didn't reply or has no beacon yet
+	LastRound uint64 `json:"last_round"`
+	// LastRoundTime is the time at which LastRound was emitted, i.e. when the peer was last known to be synced
+	LastRoundTime *time.Time `json:"last_round_time,omitempty"`
+	// Behind is the number of rounds between LastRound and the current round of the chain, unset if the peer didn't
+	// reply since how far it is behind is unknown then
+	Behind *uint64 `json:"behind,omitempty"`
+}
+
+// peersCmd lists the nodes of the group of the running daemon, whether the daemon can reach them and how far their
+// chain is synced. It relies on the daemon's remote status, which pings each node and asks it for its own status.
+func peersCmd(c *cli.Context, l log.Logger) error {
+	client, err := controlClient(c, l)
+	if err != nil {
+		return err
+	}
+	beaconID := getBeaconID(c)
+
+	groupPacket, err := client.GroupFile(beaconID)
+	if err != nil {
+		return fmt.Errorf("beacon id [%s] - unable to fetch the group file, has the DKG been run? %w", beaconID, err)
+	}
+	group, err := key.GroupFromProto(groupPacket, nil)
+	if err != nil {
+		return err
+	}
+	identity, err := client.PublicKey(beaconID)
+	if err != nil {
+		return err
+	}
+
+	addresses := make([]*control.Address, len(group.Nodes))
+	for i, node := range group.Nodes {
+		addresses[i] = &control.Address{Address: node.Address()}
+	}
+	statuses, err := client.RemoteStatus(c.Context, addresses, beaconID)
+	if err != nil {
+		return err
+	}
+	own, ok := statuses[identity.Addr]
+	if !ok {
+		return fmt.Errorf("beacon id [%s] - the daemon didn't report its own status", beaconID)
+	}
+
+	current := common.CurrentRound(time.Now().Unix(), group.Period, group.GenesisTime)
+	peers := make([]peerStatus, len(group.Nodes))
+	for i, node := range group.Nodes {
+		addr := node.Address()
+		peer := peerStatus{
+			Address:   addr,
+			Reachable: addr == identity.Addr || own.GetConnections()[addr],
+		}
+		if status, ok := statuses[addr]; ok {
+			peer.Replied = true
+			if store := status.GetChainStore(); store != nil && !store.IsEmpty {
+				peer.LastRound = store.LastStored
+				at := time.Unix(common.TimeOfRound(group.Period, group.GenesisTime, store.LastStored), 0).UTC()
+				peer.LastRoundTime = &at
+			}
+			var behind uint64
+			if current > peer.LastRound {
+				behind = current - peer.LastRound
+			}
+			peer.Behind = &behind
+		}
+		peers[i] = peer
+	}
+
+	if c.IsSet(jsonFlag.Name) {
+		return printJSON(c.App.Writer, peers)
+	}
+
+	tw := table.NewWriter()
+	tw.AppendHeader(table.Row{"Node", "Reachable", "Latest round", "Latest round time", "Rounds behind"})
+	for _, peer := range peers {
+		lastRound, lastRoundTime, behind := "-", "-", "unknown"
+		if peer.Behind != nil {
+			behind = fmt.Sprint(*peer.Behind)
+		}
+		switch {
+		case !peer.Replied:
+			lastRound = "no reply"
+		case peer.LastRoundTime != nil:
+			lastRound = fmt.Sprint(peer.LastRound)
+			lastRoundTime = peer.LastRoundTime.Format(time.RFC3339)
+		}
+		tw.AppendRow(table.Row{peer.Address, peer.Reachable, lastRound, lastRoundTime, behind})
+	}
+	fmt.Fprintf(c.App.Writer, "peers of beacon id [%s], current round %d\n", beaconID, current)
+	fmt.Fprintln(c.App.Writer, tw.Render())
+	return nil
+}
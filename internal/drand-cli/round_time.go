@@ -0,0 +1,105 @@
+package drand
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/drand/drand/v2/common"
+	"github.com/drand/drand/v2/common/chain"
+)
+
+var roundTimeFlag = &cli.StringFlag{
+	Name:  "time",
+	Usage: "the time to get the round of, in RFC3339 format. Defaults to now",
+}
+
+var roundFlag = &cli.Uint64Flag{
+	Name:     "round",
+	Usage:    "the round to get the time of",
+	Required: true,
+}
+
+var chainPeriodFlag = &cli.DurationFlag{
+	Name:  "period",
+	Usage: "the period of the chain, e.g. 30s",
+}
+
+var chainGenesisFlag = &cli.Int64Flag{
+	Name:  "genesis",
+	Usage: "the genesis time of the chain, as a UNIX timestamp",
+}
+
+var chainInfoFileFlag = &cli.StringFlag{
+	Name:  "chain-info",
+	Usage: "a JSON file with the chain info, as served on /info, to read the period and genesis time from",
+}
+
+// chainTiming returns the period and genesis time of a chain, from a chain info file or from the individual flags
+func chainTiming(c *cli.Context) (period time.Duration, genesis int64, err error) {
+	if c.IsSet(chainInfoFileFlag.Name) {
+		if c.IsSet(chainPeriodFlag.Name) || c.IsSet(chainGenesisFlag.Name) {
+			return 0, 0, fmt.Errorf("--%s can't be combined with --%s or --%s",
+				chainInfoFileFlag.Name, chainPeriodFlag.Name, chainGenesisFlag.Name)
+		}
+		f, err := os.Open(c.String(chainInfoFileFlag.Name))
+		if err != nil {
+			return 0, 0, err
+		}
+		defer f.Close()
+		info, err := chain.InfoFromJSON(f)
+		if err != nil {
+			return 0, 0, err
+		}
+		return info.Period, info.GenesisTime, nil
+	}
+
+	if !c.IsSet(chainPeriodFlag.Name) || !c.IsSet(chainGenesisFlag.Name) {
+		return 0, 0, fmt.Errorf("either --%s or both --%s and --%s are required",
+			chainInfoFileFlag.Name, chainPeriodFlag.Name, chainGenesisFlag.Name)
+	}
+	period = c.Duration(chainPeriodFlag.Name)
+	if period < time.Second || period%time.Second != 0 {
+		return 0, 0, errors.New("the period must be a whole number of seconds")
+	}
+	return period, c.Int64(chainGenesisFlag.Name), nil
+}
+
+// roundAtCmd prints the round of a chain active at the given time, without contacting any node
+func roundAtCmd(c *cli.Context) error {
+	period, genesis, err := chainTiming(c)
+	if err != nil {
+		return err
+	}
+
+	at := time.Now()
+	if c.IsSet(roundTimeFlag.Name) {
+		at, err = time.Parse(time.RFC3339, c.String(roundTimeFlag.Name))
+		if err != nil {
+			return fmt.Errorf("invalid time: %w", err)
+		}
+	}
+
+	fmt.Fprintln(c.App.Writer, common.CurrentRound(at.Unix(), period, genesis))
+	return nil
+}
+
+// timeOfCmd prints the time at which the given round of a chain is emitted, without contacting any node
+func timeOfCmd(c *cli.Context) error {
+	period, genesis, err := chainTiming(c)
+	if err != nil {
+		return err
+	}
+
+	round := c.Uint64(roundFlag.Name)
+	t := common.TimeOfRound(period, genesis, round)
+	if t == common.TimeOfRoundErrorValue {
+		return fmt.Errorf("round %d is too far in the future", round)
+	}
+
+	fmt.Fprintln(c.App.Writer, time.Unix(t, 0).UTC().Format(time.RFC3339))
+	return nil
+}
@@ -0,0 +1,157 @@
+package drand
+
+import (
+	"errors"
+	"fmt"
+	"path"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/drand/drand/v2/common/key"
+	"github.com/drand/drand/v2/common/log"
+	"github.com/drand/drand/v2/crypto"
+	"github.com/drand/drand/v2/crypto/vault"
+	"github.com/drand/drand/v2/internal/chain"
+	"github.com/drand/drand/v2/internal/chain/boltdb"
+	"github.com/drand/drand/v2/internal/core"
+	"github.com/drand/drand/v2/internal/fs"
+)
+
+// selfTestMessage is the message signed and verified with the node's share during the self-test.
+var selfTestMessage = []byte("drand self-test")
+
+// selfTestReport accumulates the outcome of the self-test checks and prints them as a checklist.
+type selfTestReport struct {
+	c      *cli.Context
+	failed []string
+}
+
+// errSelfTestSkipped marks the checks that could not be run, which are reported without failing the self-test.
+var errSelfTestSkipped = errors.New("skipped")
+
+func (r *selfTestReport) check(name string, err error) bool {
+	if errors.Is(err, errSelfTestSkipped) {
+		fmt.Fprintf(r.c.App.Writer, "[SKIP] %s: %v\n", name, err)
+		return false
+	}
+	if err != nil {
+		fmt.Fprintf(r.c.App.Writer, "[FAIL] %s: %v\n", name, err)
+		r.failed = append(r.failed, name)
+		return false
+	}
+	fmt.Fprintf(r.c.App.Writer, "[PASS] %s\n", name)
+	return true
+}
+
+// selfTestCmd runs a post-deploy smoke test of the local node: it loads its keys, opens its store,
+// produces and verifies a partial signature with its share and reaches the other members of its group.
+//
+//nolint:gocyclo
+func selfTestCmd(c *cli.Context, l log.Logger) error {
+	conf := contextToConfig(c, l)
+	beaconID := getBeaconID(c)
+	report := &selfTestReport{c: c}
+
+	fmt.Fprintf(c.App.Writer, "drand self-test for beacon id [%s]\n", beaconID)
+
+	ks := key.NewFileStore(conf.ConfigFolderMB(), beaconID)
+	pair, err := ks.LoadKeyPair()
+	if err == nil {
+		err = pair.Public.ValidSignature()
+	}
+	report.check("load and validate the long-term key pair", err)
+
+	group, err := ks.LoadGroup()
+	if err == nil && group == nil {
+		err = errors.New("no group file found, has the DKG been run?")
+	}
+	hasGroup := report.check("load the group file", err)
+
+	share, err := ks.LoadShare()
+	hasShare := report.check("load the private share", err)
+
+	report.check("open the beacon store", selfTestStore(c, l, conf, beaconID, group))
+
+	if hasGroup && hasShare {
+		report.check("sign and verify a partial beacon", selfTestPartial(l, group, share))
+	}
+
+	if hasGroup && pair != nil {
+		for _, node := range group.Nodes {
+			if node.Address() == pair.Public.Address() {
+				continue
+			}
+			report.check(fmt.Sprintf("reach peer %s", node.Address()), checkIdentityAddress(l, node.Address(), beaconID))
+		}
+	}
+
+	if len(report.failed) > 0 {
+		return fmt.Errorf("self-test failed %d check(s)", len(report.failed))
+	}
+	fmt.Fprintln(c.App.Writer, "drand self-test passed")
+	return nil
+}
+
+// selfTestStore checks the beacon store through the daemon when it is running, since it holds the lock on
+// the database, and opens the bolt store from disk otherwise. The other engines can only be checked through
+// the daemon.
+func selfTestStore(c *cli.Context, l log.Logger, conf *core.Config, beaconID string, group *key.Group) error {
+	client, err := controlClient(c, l)
+	if err == nil && client.Ping() == nil {
+		status, err := client.Status(beaconID)
+		if err != nil {
+			return fmt.Errorf("daemon is running but could not report its status: %w", err)
+		}
+		if status.GetChainStore().GetIsEmpty() {
+			return errors.New("daemon store is empty")
+		}
+		return nil
+	}
+
+	if engine := conf.StorageEngine(); engine != chain.BoltDB {
+		return fmt.Errorf("%w: the %s store can only be checked while the daemon is running", errSelfTestSkipped, engine)
+	}
+	if group == nil {
+		return errors.New("the scheme of the chain is unknown without the group file")
+	}
+
+	ctx := c.Context
+	if group.Scheme.Name == crypto.DefaultSchemeID {
+		ctx = chain.SetPreviousRequiredOnContext(ctx)
+	}
+
+	dbFolder := conf.DBFolder(beaconID)
+	// we don't want the self-test to create an empty database as a side effect
+	exists, err := fs.Exists(path.Join(dbFolder, boltdb.BoltFileName))
+	if err != nil {
+		return fmt.Errorf("could not look for the beacon database in %s: %w", dbFolder, err)
+	}
+	if !exists {
+		return fmt.Errorf("no beacon database found in %s", dbFolder)
+	}
+
+	store, err := boltdb.NewBoltStore(ctx, l, dbFolder)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	_, err = store.Last(ctx)
+	return err
+}
+
+// selfTestPartial signs a test message with the node's share and verifies it against the group's public key.
+func selfTestPartial(l log.Logger, group *key.Group, share *key.Share) error {
+	if group.Scheme.Name != share.Scheme.Name {
+		return fmt.Errorf("group scheme %s does not match share scheme %s", group.Scheme.Name, share.Scheme.Name)
+	}
+	v := vault.NewVault(l, group, share, group.Scheme)
+	sig, err := v.SignPartial(selfTestMessage)
+	if err != nil {
+		return fmt.Errorf("could not sign: %w", err)
+	}
+	if err := v.ThresholdScheme.VerifyPartial(v.GetPub(), selfTestMessage, sig); err != nil {
+		return fmt.Errorf("partial signature does not verify against the group public key: %w", err)
+	}
+	return nil
+}
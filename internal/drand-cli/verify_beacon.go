@@ -0,0 +1,77 @@
+package drand
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/drand/drand/v2/common"
+	"github.com/drand/drand/v2/crypto"
+)
+
+var verifyPublicFlag = &cli.StringFlag{
+	Name:     "public",
+	Usage:    "the public key of the chain, in hexadecimal",
+	Required: true,
+}
+
+var verifyRoundFlag = &cli.Uint64Flag{
+	Name:     "round",
+	Usage:    "the round of the beacon to verify",
+	Required: true,
+}
+
+var verifySignatureFlag = &cli.StringFlag{
+	Name:     "signature",
+	Usage:    "the signature of the beacon to verify, in hexadecimal",
+	Required: true,
+}
+
+var verifyPreviousFlag = &cli.StringFlag{
+	Name:  "previous",
+	Usage: "the signature of the previous round, in hexadecimal. Only chained schemes need it",
+}
+
+// verifyBeaconCmd verifies a single beacon against the public key of its chain, without contacting any node
+func verifyBeaconCmd(c *cli.Context) error {
+	sch, err := crypto.SchemeFromName(c.String(schemeFlag.Name))
+	if err != nil {
+		return err
+	}
+
+	pubBytes, err := hex.DecodeString(c.String(verifyPublicFlag.Name))
+	if err != nil {
+		return fmt.Errorf("invalid public key: %w", err)
+	}
+	pub := sch.KeyGroup.Point()
+	if err := pub.UnmarshalBinary(pubBytes); err != nil {
+		return fmt.Errorf("invalid public key for scheme %s: %w", sch.Name, err)
+	}
+
+	round := c.Uint64(verifyRoundFlag.Name)
+	if round == 0 {
+		return fmt.Errorf("round 0 is the genesis beacon and can't be verified")
+	}
+	sig, err := hex.DecodeString(c.String(verifySignatureFlag.Name))
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+	b := &common.Beacon{Round: round, Signature: sig}
+	if sch.Name == crypto.DefaultSchemeID {
+		if !c.IsSet(verifyPreviousFlag.Name) {
+			return fmt.Errorf("--%s is required to verify a beacon of scheme %s", verifyPreviousFlag.Name, sch.Name)
+		}
+		b.PreviousSig, err = hex.DecodeString(c.String(verifyPreviousFlag.Name))
+		if err != nil {
+			return fmt.Errorf("invalid previous signature: %w", err)
+		}
+	}
+
+	if err := sch.VerifyBeacon(b, pub); err != nil {
+		fmt.Fprintf(c.App.Writer, "round %d: invalid\n", round)
+		return fmt.Errorf("invalid beacon for round %d: %w", round, err)
+	}
+	fmt.Fprintf(c.App.Writer, "round %d: valid\n", round)
+	return nil
+}
@@ -2,6 +2,8 @@ package metrics
 
 import (
 	"context"
+	"crypto/subtle"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
@@ -63,12 +65,61 @@ var (
 		Help: "Discrepancy between beacon creation time and calculated round time",
 	}, []string{"beacon_id"})
 
+	// BeaconDeliveryDelay (Group) seconds between the time of a round and its beacon being stored.
+	BeaconDeliveryDelay = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "drand_beacon_delivery_delay_seconds",
+		Help: "Duration between the time of a round and its beacon being stored",
+		//nolint:mnd // from 10ms up to ~40s
+		Buckets: prometheus.ExponentialBuckets(0.01, 2, 13),
+	}, []string{"beacon_id"})
+
 	// LastBeaconRound is the most recent round (as also seen at /health) stored.
 	LastBeaconRound = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "last_beacon_round",
 		Help: "Last locally stored beacon",
 	}, []string{"beacon_id"})
 
+	// LastRoundAge (Group) seconds since the expected time of the most recent round stored.
+	LastRoundAge = newLastRoundAgeCollector()
+
+	// BeaconStoreLag (Group) seconds between a beacon being aggregated and being committed to the store. Unlike
+	// BeaconDiscrepancyLatency, which measures how late a beacon is stored compared to the time of its round, it
+	// only covers the time spent writing to the store.
+	BeaconStoreLag = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "drand_beacon_store_lag_seconds",
+		Help: "Duration between a beacon being aggregated and being committed to the store. Unlike " +
+			"beacon_discrepancy_latency, it excludes the time taken to collect the partials of the round",
+		//nolint:mnd // from 1ms up to ~16s
+		Buckets: prometheus.ExponentialBuckets(0.001, 2, 15),
+	}, []string{"beacon_id"})
+
+	// BeaconPartialsReceived (Private) how many partial signatures were collected for a round when recovering its
+	// signature.
+	BeaconPartialsReceived = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "drand_beacon_partials_received",
+		Help: "Number of valid partial signatures collected for a round at recovery time",
+		//nolint:mnd // from 1 up to 128 partials
+		Buckets: prometheus.ExponentialBuckets(1, 2, 8),
+	}, []string{"beacon_id"})
+
+	// BeaconRoundFailures (Private) how many rounds the node failed to aggregate, by reason.
+	BeaconRoundFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "drand_beacon_round_failures_total",
+		Help: "Number of rounds the node failed to aggregate, by reason",
+	}, []string{"beacon_id", "reason"})
+
+	// BeaconStalled (Private) whether the beacon loop of a beacon stopped handling rounds.
+	BeaconStalled = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "drand_beacon_stalled",
+		Help: "Whether the beacon loop stopped handling rounds: 1 = stalled, 0 = running",
+	}, []string{"beacon_id"})
+
+	// BeaconPartialVerificationQueue (Private) how many partial signatures are waiting to be verified.
+	BeaconPartialVerificationQueue = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "drand_beacon_partial_verification_queue",
+		Help: "Number of partial signatures waiting for a free verifier",
+	}, []string{"beacon_id"})
+
 	// HTTPCallCounter (HTTP) how many http requests
 	HTTPCallCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
 		Name: "http_call_counter",
@@ -186,6 +237,12 @@ var (
 		Help: "Is this node the leader during DKG? 0-false, 1-true",
 	}, []string{"beacon_id"})
 
+	// DKGRetransmissions (Group) counts the DKG packets resent to a peer that failed to receive them
+	DKGRetransmissions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "dkg_retransmissions",
+		Help: "Number of DKG packets resent to a peer that failed to receive them",
+	}, []string{"beacon_id", "peer_address"})
+
 	// reshareState (Group) tracks reshare status changes
 	reshareState = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "reshare_state",
@@ -223,6 +280,18 @@ var (
 		Help: "The database type the node is running with. 1=bolt-trimmed, 2=postgres, 3=memdb, 4=bolt-untrimmed",
 	}, []string{"beaconID", "db_type"})
 
+	// MemDBRounds (Group) is the number of rounds held by the in-memory store
+	MemDBRounds = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "memdb_rounds",
+		Help: "Number of rounds held by the in-memory store",
+	}, []string{"beacon_id"})
+
+	// MemDBEstimatedBytes (Group) is the estimated memory used by the rounds of the in-memory store
+	MemDBEstimatedBytes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "memdb_estimated_bytes",
+		Help: "Estimated memory used by the rounds held by the in-memory store, in bytes",
+	}, []string{"beacon_id"})
+
 	// OutgoingConnectionState (Group) tracks the state of an outgoing connection, using the states from
 	// https://github.com/grpc/grpc-go/blob/8075dd35d2738b352c4355b4b353dc1e9183bea7/connectivity/connectivity.go#L51-L62
 	// Due to the fact that grpc-go doesn't support adding a listener for state tracking, this is
@@ -261,6 +330,20 @@ func bindMetrics(l log.Logger) {
 		return
 	}
 
+	// Private metrics
+	private := []prometheus.Collector{
+		BeaconPartialsReceived,
+		BeaconRoundFailures,
+		BeaconStalled,
+		BeaconPartialVerificationQueue,
+	}
+	for _, c := range private {
+		if err := PrivateMetrics.Register(c); err != nil {
+			l.Errorw("error in bindMetrics", "metrics", "bindMetrics", "err", err)
+			return
+		}
+	}
+
 	// Group metrics
 	group := []prometheus.Collector{
 		APICallCounter,
@@ -270,10 +353,14 @@ func bindMetrics(l log.Logger) {
 		GroupThreshold,
 		BeaconDiscrepancyLatency,
 		LastBeaconRound,
+		LastRoundAge,
+		BeaconStoreLag,
+		BeaconDeliveryDelay,
 		drandBuildTime,
 		dkgState,
 		dkgStateTimestamp,
 		dkgLeader,
+		DKGRetransmissions,
 		reshareState,
 		reshareStateTimestamp,
 		reshareLeader,
@@ -281,6 +368,8 @@ func bindMetrics(l log.Logger) {
 		IsDrandNode,
 		DrandStartTimestamp,
 		DrandStorageBackend,
+		MemDBRounds,
+		MemDBEstimatedBytes,
 		ErrorSendingPartialCounter,
 	}
 	for _, c := range group {
@@ -352,8 +441,18 @@ type Client interface {
 	GetMetrics(ctx context.Context, p string) (string, error)
 }
 
+// ServerOptions secures the metrics server, which serves plaintext HTTP to anyone by default.
+type ServerOptions struct {
+	// CertFile and KeyFile are the PEM encoded certificate and key the server uses to serve TLS
+	CertFile string
+	KeyFile  string
+	// BearerToken, if set, must be sent by the scrapers in an "Authorization: Bearer" header
+	BearerToken string
+}
+
 // Start starts a prometheus metrics server with debug endpoints. If metricsBind is 0 it will use an available port.
-func Start(logger log.Logger, metricsBind string, pprof http.Handler, cli Client) net.Listener {
+// The server is served over TLS if opts has a certificate.
+func Start(logger log.Logger, metricsBind string, pprof http.Handler, cli Client, opts ServerOptions) net.Listener {
 	logger.Infow("metrics starting", "desired_port", metricsBind)
 
 	metricsBound.Do(func() {
@@ -364,12 +463,25 @@ func Start(logger log.Logger, metricsBind string, pprof http.Handler, cli Client
 	if !strings.Contains(metricsBind, ":") {
 		metricsBind = "127.0.0.1:" + metricsBind
 	}
+	var tlsConfig *tls.Config
+	if opts.CertFile != "" || opts.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(opts.CertFile, opts.KeyFile)
+		if err != nil {
+			logger.Errorw("", "metrics", "unable to load the TLS certificate", "err", err)
+			return nil
+		}
+		tlsConfig = &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+	}
+
 	l, err := net.Listen("tcp", metricsBind)
 	if err != nil {
 		logger.Warnw("", "metrics", "listen failed", "err", err)
 		return nil
 	}
-	logger.Infow("metric listener started", "addr", l.Addr())
+	if tlsConfig != nil {
+		l = tls.NewListener(l, tlsConfig)
+	}
+	logger.Infow("metric listener started", "addr", l.Addr(), "tls", tlsConfig != nil)
 
 	mux := http.NewServeMux()
 	mux.Handle("/metrics", promhttp.HandlerFor(PrivateMetrics, promhttp.HandlerOpts{Registry: PrivateMetrics}))
@@ -385,13 +497,31 @@ func Start(logger log.Logger, metricsBind string, pprof http.Handler, cli Client
 		fmt.Fprintf(w, "GC run complete")
 	})
 
-	s := http.Server{Addr: l.Addr().String(), ReadHeaderTimeout: 3 * time.Second, Handler: mux}
+	var handler http.Handler = mux
+	if opts.BearerToken != "" {
+		handler = requireBearerToken(opts.BearerToken, mux)
+	}
+
+	s := http.Server{Addr: l.Addr().String(), ReadHeaderTimeout: 3 * time.Second, Handler: handler}
 	go func() {
 		logger.Warnw("", "metrics", "listen finished", "err", s.Serve(l))
 	}()
 	return l
 }
 
+// requireBearerToken only lets through the requests authorized with the given bearer token
+func requireBearerToken(token string, next http.Handler) http.Handler {
+	expected := []byte("Bearer " + token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), expected) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
 // remotePeerHandler is a structure that handles all peers that
 // this node is connected to regardless of which group they are a part of.
 type remotePeerHandler struct {
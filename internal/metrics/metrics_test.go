@@ -1,8 +1,24 @@
 package metrics
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drand/drand/v2/common/testlogger"
 )
 
 // Note that the remote peer metrics are tested in TestMetricsForPeer in cli_test.go
@@ -21,3 +37,122 @@ func TestBuildTimestamp(t *testing.T) {
 		t.Fatalf("Error converting build timestamp to number. Expected %v, actual %v", expected, actual)
 	}
 }
+
+func TestLastRoundAge(t *testing.T) {
+	c := newLastRoundAgeCollector()
+	now := time.Unix(1_000_000, 0)
+	c.now = func() time.Time { return now }
+	registry := prometheus.NewRegistry()
+	if err := registry.Register(c); err != nil {
+		t.Fatal(err)
+	}
+	age := func() float64 {
+		families, err := registry.Gather()
+		if err != nil || len(families) != 1 || len(families[0].GetMetric()) != 1 {
+			t.Fatalf("unexpected metrics %v: %v", families, err)
+		}
+		return families[0].GetMetric()[0].GetGauge().GetValue()
+	}
+
+	c.set("default", now.Add(-3*time.Second))
+	if a := age(); a != 3 {
+		t.Fatalf("expected an age of 3s, got %v", a)
+	}
+
+	// the age keeps growing as long as no new round is stored
+	now = now.Add(30 * time.Second)
+	if a := age(); a != 33 {
+		t.Fatalf("expected an age of 33s, got %v", a)
+	}
+
+	c.set("default", now)
+	if a := age(); a != 0 {
+		t.Fatalf("expected an age of 0s, got %v", a)
+	}
+}
+
+// writeCertificate writes a self-signed certificate for 127.0.0.1 and its key in dir
+func TestBeaconStoreLag(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	require.NoError(t, registry.Register(BeaconStoreLag))
+
+	BeaconStoreLag.WithLabelValues("store_lag").Observe(0.25)
+	BeaconStoreLag.WithLabelValues("store_lag").Observe(0.5)
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	require.Len(t, families, 1)
+	require.Equal(t, "drand_beacon_store_lag_seconds", families[0].GetName())
+
+	var found bool
+	for _, m := range families[0].GetMetric() {
+		if m.GetLabel()[0].GetValue() != "store_lag" {
+			continue
+		}
+		found = true
+		require.Equal(t, uint64(2), m.GetHistogram().GetSampleCount())
+		require.InDelta(t, 0.75, m.GetHistogram().GetSampleSum(), 1e-9)
+	}
+	require.True(t, found)
+}
+
+func writeCertificate(t *testing.T, dir string) (certFile, keyFile string, cert *x509.Certificate) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err = x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile = filepath.Join(dir, "cert.pem")
+	keyFile = filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600))
+	return certFile, keyFile, cert
+}
+
+func TestStartTLS(t *testing.T) {
+	certFile, keyFile, cert := writeCertificate(t, t.TempDir())
+	opts := ServerOptions{CertFile: certFile, KeyFile: keyFile, BearerToken: "secret"}
+	l := Start(testlogger.New(t), "127.0.0.1:0", nil, nil, opts)
+	require.NotNil(t, l)
+	defer l.Close()
+
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}}}
+	url := "https://" + l.Addr().String() + "/metrics"
+	get := func(token string) int {
+		req, err := http.NewRequest(http.MethodGet, url, http.NoBody)
+		require.NoError(t, err)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	require.Equal(t, http.StatusUnauthorized, get(""))
+	require.Equal(t, http.StatusUnauthorized, get("wrong"))
+	require.Equal(t, http.StatusOK, get("secret"))
+
+	// plaintext requests are refused
+	resp, err := http.Get("http://" + l.Addr().String() + "/metrics")
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	// the server doesn't start with a missing certificate rather than serving plaintext
+	require.Nil(t, Start(testlogger.New(t), "127.0.0.1:0", nil, nil, ServerOptions{CertFile: certFile}))
+}
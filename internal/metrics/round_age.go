@@ -0,0 +1,58 @@
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// lastRoundAgeCollector reports, for each beacon, the time elapsed since the expected time of the last round
+// it stored. The age is computed when the metric is scraped rather than when the round is stored, so that it
+// keeps growing when a node stops producing beacons.
+type lastRoundAgeCollector struct {
+	sync.Mutex
+	desc     *prometheus.Desc
+	expected map[string]time.Time
+	now      func() time.Time
+}
+
+func newLastRoundAgeCollector() *lastRoundAgeCollector {
+	return &lastRoundAgeCollector{
+		desc: prometheus.NewDesc(
+			"drand_beacon_last_round_age_seconds",
+			"Seconds elapsed since the expected time of the last locally stored beacon",
+			[]string{"beacon_id"},
+			nil,
+		),
+		expected: make(map[string]time.Time),
+		now:      time.Now,
+	}
+}
+
+func (c *lastRoundAgeCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *lastRoundAgeCollector) Collect(ch chan<- prometheus.Metric) {
+	c.Lock()
+	defer c.Unlock()
+
+	now := c.now()
+	for beaconID, expected := range c.expected {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, now.Sub(expected).Seconds(), beaconID)
+	}
+}
+
+func (c *lastRoundAgeCollector) set(beaconID string, expected time.Time) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.expected[beaconID] = expected
+}
+
+// LastRoundStored records the expected time of the last round stored by the given beacon, from which the
+// drand_beacon_last_round_age_seconds metric is computed.
+func LastRoundStored(beaconID string, expected time.Time) {
+	LastRoundAge.set(beaconID, expected)
+}
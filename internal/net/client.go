@@ -64,6 +64,15 @@ func listenAddrFor(listenAddr string) (network, addr string) {
 	return grpcDefaultIPNetwork, fmt.Sprintf("%s:%s", "127.0.0.1", listenAddr)
 }
 
+// dialTarget returns the gRPC target to dial for the given host:port address. gRPC parses targets as URLs, so the
+// zone of IPv6 literals such as [fe80::1%eth0]:8080 has to be escaped, keeping the dns resolver gRPC uses by default.
+func dialTarget(addr string) string {
+	if !strings.Contains(addr, "%") {
+		return addr
+	}
+	return "dns:///" + strings.ReplaceAll(addr, "%", "%25")
+}
+
 type DKGClient interface {
 	Packet(ctx context.Context, p Peer, packet *pdkg.GossipPacket, opts ...grpc.CallOption) (*pdkg.EmptyDKGResponse, error)
 	BroadcastDKG(ctx context.Context, p Peer, in *pdkg.DKGPacket, opts ...grpc.CallOption) (*pdkg.EmptyDKGResponse, error)
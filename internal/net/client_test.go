@@ -0,0 +1,58 @@
+package net
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/nettest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/drand/drand/v2/common/testlogger"
+	testnet "github.com/drand/drand/v2/internal/test/net"
+)
+
+func TestDialTarget(t *testing.T) {
+	for addr, expected := range map[string]string{
+		"127.0.0.1:8080":        "127.0.0.1:8080",
+		"drand.example.com:443": "drand.example.com:443",
+		"[::1]:8080":            "[::1]:8080",
+		"[fe80::1%eth0]:8080":   "dns:///[fe80::1%25eth0]:8080",
+	} {
+		require.Equal(t, expected, dialTarget(addr))
+	}
+}
+
+func TestControlIPv6Zone(t *testing.T) {
+	if !nettest.SupportsIPv6() {
+		t.Skip("Platform does not support IPv6.")
+	}
+	var zone string
+	ifaces, err := net.Interfaces()
+	require.NoError(t, err)
+	for _, iface := range ifaces {
+		if iface.Flags&net.FlagLoopback != 0 {
+			zone = iface.Name
+		}
+	}
+	if zone == "" {
+		t.Skip("No loopback interface to use as a zone.")
+	}
+
+	lg := testlogger.New(t)
+	service, err := NewGRPCListener(lg, &testnet.EmptyServer{}, "[::1]:0")
+	require.NoError(t, err)
+	go service.Start()
+	defer service.Stop()
+
+	_, port, err := net.SplitHostPort(service.lis.Addr().String())
+	require.NoError(t, err)
+	client, err := NewControlClient(lg, "[::1%"+zone+"]:"+port)
+	require.NoError(t, err)
+	defer client.conn.Close()
+
+	// the empty server doesn't reply, but the connection itself must succeed
+	err = client.Ping()
+	require.NotEqual(t, codes.Unavailable, status.Code(err), err)
+}
@@ -44,7 +44,7 @@ func (g *grpcClient) conn(p Peer) (*grpc.ClientConn, error) {
 			g.opts...,
 		)
 
-		c, err = grpc.NewClient(p.Address(), opts...)
+		c, err = grpc.NewClient(dialTarget(p.Address()), opts...)
 		if err != nil {
 			g.log.Errorw("error initiating a new non-TLS grpc conn", "to", p.Address(), "err", err)
 			// We increase the GroupDialFailures counter when both failed
@@ -88,6 +88,8 @@ func NewControlClient(l log.Logger, addr string) (*ControlClient, error) {
 	network, host := listenAddrFor(addr)
 	if network != grpcDefaultIPNetwork {
 		host = fmt.Sprintf("%s://%s", network, host)
+	} else {
+		host = dialTarget(host)
 	}
 
 	conn, err := grpc.NewClient(host, grpc.WithTransportCredentials(insecure.NewCredentials()))
@@ -195,9 +197,21 @@ func (c *ControlClient) GroupFile(beaconID string) (*proto.GroupPacket, error) {
 
 // Shutdown stops the daemon
 func (c *ControlClient) Shutdown(beaconID string) (*proto.ShutdownResponse, error) {
+	return c.ShutdownWithTimeout(beaconID, 0)
+}
+
+// ShutdownWithTimeout stops the daemon, waiting at most for the given timeout for it to stop cleanly.
+// The daemon returns an error naming the subsystems that failed to stop in time.
+// A zero timeout means that the daemon's default timeouts apply.
+func (c *ControlClient) ShutdownWithTimeout(beaconID string, timeout time.Duration) (*proto.ShutdownResponse, error) {
 	metadata := proto.Metadata{NodeVersion: c.version.ToProto(), BeaconID: beaconID}
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
+	if timeout > 0 {
+		var cancelTimeout context.CancelFunc
+		ctx, cancelTimeout = context.WithTimeout(ctx, timeout)
+		defer cancelTimeout()
+	}
 	return c.client.Shutdown(ctx, &proto.ShutdownRequest{Metadata: &metadata})
 }
 
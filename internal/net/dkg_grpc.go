@@ -24,6 +24,8 @@ func grpcConnection(l log.Logger, addr string) (*grpc.ClientConn, error) {
 	network, host := listenAddrFor(addr)
 	if network != grpcDefaultIPNetwork {
 		host = fmt.Sprintf("%s://%s", network, host)
+	} else {
+		host = dialTarget(host)
 	}
 
 	conn, err := grpc.NewClient(host, grpc.WithTransportCredentials(insecure.NewCredentials()))
@@ -0,0 +1,173 @@
+package net
+
+import (
+	"context"
+	"encoding/binary"
+	"net/http"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/http2"
+	"google.golang.org/grpc"
+
+	"github.com/drand/drand/v2/common/log"
+	"github.com/drand/drand/v2/protobuf/drand"
+)
+
+const (
+	grpcContentType    = "application/grpc"
+	grpcWebContentType = "application/grpc-web"
+	grpcWebTextType    = "application/grpc-web-text"
+	// grpcWebTrailerFlag marks the last frame of a gRPC-Web response, which carries the trailers.
+	grpcWebTrailerFlag = 0x80
+)
+
+// NewGRPCWebHandler returns an http.Handler serving the Public API over gRPC-Web, so that browsers can use
+// the gRPC API (including the PublicRandStream streaming call) directly without a translating proxy.
+// Requests that are not gRPC-Web requests are passed on to the fallback handler, which allows gRPC-Web to
+// coexist with the HTTP JSON API on the same listener.
+// The calls go through the same interceptors as on the private listener, so they are recorded in the grpc
+// metrics and traces. The handler must be stopped with Stop once the listener serving it is closed.
+// Only the binary gRPC-Web format is supported, the base64 "grpc-web-text" format is rejected.
+func NewGRPCWebHandler(ctx context.Context, s Service, fallback http.Handler) (*GRPCWebHandler, error) {
+	grpcServer := grpc.NewServer(serverOptions(s)...)
+	drand.RegisterPublicServer(grpcServer, s)
+
+	if err := registerServerMetrics(log.FromContextOrDefault(ctx), grpcServer); err != nil {
+		return nil, err
+	}
+
+	return &GRPCWebHandler{
+		grpcServer: grpcServer,
+		fallback:   fallback,
+	}, nil
+}
+
+// GRPCWebHandler is the http.Handler serving the Public API over gRPC-Web, see NewGRPCWebHandler.
+type GRPCWebHandler struct {
+	grpcServer *grpc.Server
+	fallback   http.Handler
+}
+
+// Stop stops the grpc server behind the handler, closing the calls still running.
+func (g *GRPCWebHandler) Stop() {
+	g.grpcServer.Stop()
+}
+
+// IsGRPCWebRequest returns true if the request is a gRPC-Web call or a CORS preflight request for one.
+func IsGRPCWebRequest(r *http.Request) bool {
+	if r.Method == http.MethodOptions {
+		return r.Header.Get("Access-Control-Request-Method") != "" &&
+			strings.Contains(strings.ToLower(r.Header.Get("Access-Control-Request-Headers")), "x-grpc-web")
+	}
+	return r.Method == http.MethodPost && strings.HasPrefix(r.Header.Get("Content-Type"), grpcWebContentType)
+}
+
+func (g *GRPCWebHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !IsGRPCWebRequest(r) {
+		g.fallback.ServeHTTP(w, r)
+		return
+	}
+
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	if r.Method == http.MethodOptions {
+		w.Header().Set("Access-Control-Allow-Methods", http.MethodPost)
+		w.Header().Set("Access-Control-Allow-Headers", "content-type, x-grpc-web, x-user-agent, grpc-timeout")
+		w.Header().Set("Access-Control-Max-Age", "600")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	contentType := r.Header.Get("Content-Type")
+	if strings.HasPrefix(contentType, grpcWebTextType) {
+		http.Error(w, "grpc-web-text is not supported, use application/grpc-web+proto", http.StatusUnsupportedMediaType)
+		return
+	}
+
+	// the grpc server's http.Handler only accepts HTTP/2 gRPC requests, so we present the gRPC-Web request
+	// as one: the message framing of the body is identical, only the trailers differ.
+	req := r.Clone(r.Context())
+	req.ProtoMajor, req.ProtoMinor, req.Proto = 2, 0, "HTTP/2"
+	req.Header.Set("Content-Type", grpcContentType+strings.TrimPrefix(contentType, grpcWebContentType))
+
+	ww := &grpcWebResponseWriter{
+		w:           w,
+		header:      make(http.Header),
+		contentType: contentType,
+	}
+	g.grpcServer.ServeHTTP(ww, req)
+	ww.finish()
+}
+
+// grpcWebResponseWriter translates the response of the grpc server's http.Handler to gRPC-Web by
+// writing the trailers as the last length-prefixed frame of the body rather than as HTTP trailers.
+type grpcWebResponseWriter struct {
+	w           http.ResponseWriter
+	header      http.Header
+	contentType string
+	sentHeaders map[string]bool
+}
+
+func (ww *grpcWebResponseWriter) Header() http.Header {
+	return ww.header
+}
+
+func (ww *grpcWebResponseWriter) WriteHeader(code int) {
+	if ww.sentHeaders != nil {
+		return
+	}
+	ww.sentHeaders = make(map[string]bool, len(ww.header))
+	h := ww.w.Header()
+	for k, v := range ww.header {
+		ww.sentHeaders[k] = true
+		if k == "Trailer" || strings.HasPrefix(k, http2.TrailerPrefix) {
+			continue
+		}
+		h[k] = v
+	}
+	h.Set("Content-Type", ww.contentType)
+	h.Set("Access-Control-Expose-Headers", "grpc-status, grpc-message")
+	ww.w.WriteHeader(code)
+}
+
+func (ww *grpcWebResponseWriter) Write(b []byte) (int, error) {
+	ww.WriteHeader(http.StatusOK)
+	return ww.w.Write(b)
+}
+
+func (ww *grpcWebResponseWriter) Flush() {
+	ww.WriteHeader(http.StatusOK)
+	if f, ok := ww.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// finish writes the trailer frame, made of all the headers set after the response headers were sent.
+func (ww *grpcWebResponseWriter) finish() {
+	ww.WriteHeader(http.StatusOK)
+
+	keys := make([]string, 0, len(ww.header))
+	for k := range ww.header {
+		if k == "Trailer" || (ww.sentHeaders[k] && !strings.HasPrefix(k, http2.TrailerPrefix)) {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var trailers strings.Builder
+	for _, k := range keys {
+		name := strings.ToLower(strings.TrimPrefix(k, http2.TrailerPrefix))
+		for _, v := range ww.header[k] {
+			trailers.WriteString(name + ": " + v + "\r\n")
+		}
+	}
+
+	//nolint:mnd // gRPC frames have a 1 byte flag followed by a 4 bytes length prefix
+	frame := make([]byte, 5, 5+trailers.Len())
+	frame[0] = grpcWebTrailerFlag
+	binary.BigEndian.PutUint32(frame[1:], uint32(trailers.Len()))
+	frame = append(frame, trailers.String()...)
+	_, _ = ww.w.Write(frame)
+	ww.Flush()
+}
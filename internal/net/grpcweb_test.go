@@ -0,0 +1,135 @@
+package net
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	protobuf "google.golang.org/protobuf/proto"
+
+	"github.com/drand/drand/v2/internal/metrics"
+	proto "github.com/drand/drand/v2/protobuf/drand"
+)
+
+func readGRPCWebFrame(t *testing.T, r io.Reader) (byte, []byte) {
+	t.Helper()
+	prefix := make([]byte, 5)
+	_, err := io.ReadFull(r, prefix)
+	require.NoError(t, err)
+	data := make([]byte, binary.BigEndian.Uint32(prefix[1:]))
+	_, err = io.ReadFull(r, data)
+	require.NoError(t, err)
+	return prefix[0], data
+}
+
+// postPublicRand calls PublicRand over gRPC-Web on the server at url
+func postPublicRand(t *testing.T, url string) *http.Response {
+	t.Helper()
+	msg, err := protobuf.Marshal(&proto.PublicRandRequest{Round: 1})
+	require.NoError(t, err)
+	reqBody := make([]byte, 5, 5+len(msg))
+	binary.BigEndian.PutUint32(reqBody[1:], uint32(len(msg)))
+	reqBody = append(reqBody, msg...)
+
+	req, err := http.NewRequest(http.MethodPost, url+"/drand.Public/PublicRand", bytes.NewReader(reqBody))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/grpc-web+proto")
+	req.Header.Set("X-Grpc-Web", "1")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	return resp
+}
+
+// handledPublicRand returns how many PublicRand calls the grpc metrics recorded
+func handledPublicRand(t *testing.T) float64 {
+	t.Helper()
+	families, err := metrics.PrivateMetrics.Gather()
+	require.NoError(t, err)
+	var total float64
+	for _, family := range families {
+		if family.GetName() != "grpc_server_handled_total" {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			for _, label := range m.GetLabel() {
+				if label.GetName() == "grpc_method" && label.GetValue() == "PublicRand" {
+					total += m.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+	return total
+}
+
+func TestGRPCWebHandlerInstrumentation(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(previous)
+
+	randServer := &testRandomnessServer{round: 42}
+	handler, err := NewGRPCWebHandler(context.Background(), randServer, http.NotFoundHandler())
+	require.NoError(t, err)
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+	defer handler.Stop()
+
+	before := handledPublicRand(t)
+	resp := postPublicRand(t, srv.URL)
+	_, err = io.Copy(io.Discard, resp.Body)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	require.Equal(t, before+1, handledPublicRand(t))
+
+	var names []string
+	for _, span := range recorder.Ended() {
+		names = append(names, span.Name())
+	}
+	require.Contains(t, names, "drand.Public/PublicRand")
+}
+
+func TestGRPCWebHandler(t *testing.T) {
+	randServer := &testRandomnessServer{round: 42}
+	fallback := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	})
+	handler, err := NewGRPCWebHandler(context.Background(), randServer, fallback)
+	require.NoError(t, err)
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+	defer handler.Stop()
+
+	// regular HTTP requests still reach the fallback handler
+	resp, err := http.Get(srv.URL + "/info")
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, "ok", string(body))
+
+	resp = postPublicRand(t, srv.URL)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "application/grpc-web+proto", resp.Header.Get("Content-Type"))
+
+	flag, data := readGRPCWebFrame(t, resp.Body)
+	require.Equal(t, byte(0), flag)
+	rand := new(proto.PublicRandResponse)
+	require.NoError(t, protobuf.Unmarshal(data, rand))
+	require.Equal(t, randServer.round, rand.GetRound())
+
+	flag, data = readGRPCWebFrame(t, resp.Body)
+	require.Equal(t, byte(grpcWebTrailerFlag), flag)
+	require.Contains(t, string(data), "grpc-status: 0\r\n")
+}
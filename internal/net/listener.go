@@ -44,7 +44,38 @@ func NewGRPCListenerForPrivate(ctx context.Context, bindingAddr string, s Servic
 
 	l := log.FromContextOrDefault(ctx)
 
-	opts = append(opts,
+	opts = append(opts, serverOptions(s)...)
+
+	grpcServer := grpc.NewServer(opts...)
+
+	// support GRPC health checking
+	healthcheck := health.NewServer()
+	healthgrpc.RegisterHealthServer(grpcServer, healthcheck)
+
+	drand.RegisterPublicServer(grpcServer, s)
+	drand.RegisterProtocolServer(grpcServer, s)
+	pdkg.RegisterDKGControlServer(grpcServer, s)
+
+	g := &grpcListener{
+		Service:      s,
+		grpcServer:   grpcServer,
+		lis:          lis,
+		healthServer: healthcheck,
+	}
+
+	drand.RegisterMetricsServer(grpcServer, s)
+
+	if err := registerServerMetrics(l, grpcServer); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// serverOptions returns the interceptors and stats handler shared by all the grpc servers serving the APIs of the
+// node, so that their calls are all recorded in the grpc metrics and traces.
+func serverOptions(s drand.Interceptors) []grpc.ServerOption {
+	return []grpc.ServerOption{
 		grpc.StreamInterceptor(
 			grpcmiddleware.ChainStreamServer(
 				grpcprometheus.StreamServerInterceptor,
@@ -63,37 +94,20 @@ func NewGRPCListenerForPrivate(ctx context.Context, bindingAddr string, s Servic
 		// this limits the number of concurrent streams to each ServerTransport to prevent potential remote DoS
 		//nolint:mnd
 		grpc.MaxConcurrentStreams(256),
-	)
-
-	grpcServer := grpc.NewServer(opts...)
-
-	// support GRPC health checking
-	healthcheck := health.NewServer()
-	healthgrpc.RegisterHealthServer(grpcServer, healthcheck)
-
-	drand.RegisterPublicServer(grpcServer, s)
-	drand.RegisterProtocolServer(grpcServer, s)
-	pdkg.RegisterDKGControlServer(grpcServer, s)
-
-	g := &grpcListener{
-		Service:      s,
-		grpcServer:   grpcServer,
-		lis:          lis,
-		healthServer: healthcheck,
 	}
+}
 
+// registerServerMetrics initializes the grpc metrics of the services of grpcServer, and registers the grpc
+// metrics on the private metrics the first time it is called.
+func registerServerMetrics(l log.Logger, grpcServer *grpc.Server) error {
 	grpcprometheus.Register(grpcServer)
-	drand.RegisterMetricsServer(grpcServer, s)
 
 	state.Lock()
 	defer state.Unlock()
 	if !isGrpcPrometheusMetricsRegisted {
-		if err := registerGRPCMetrics(l); err != nil {
-			return nil, err
-		}
+		return registerGRPCMetrics(l)
 	}
-
-	return g, nil
+	return nil
 }
 
 // NewRESTListenerForPublic creates a new listener for the Public API over REST.
@@ -129,6 +129,10 @@ func (s *EmptyServer) DKGStatus(_ context.Context, _ *pdkg.DKGStatusRequest) (*p
 	return nil, nil
 }
 
+func (s *EmptyServer) DKGState(_ context.Context, _ *pdkg.DKGStatusRequest) (*pdkg.DKGStateResponse, error) {
+	return nil, nil
+}
+
 func (s *EmptyServer) Migrate(_ context.Context, _ *drand.Empty) (*drand.Empty, error) {
 	return nil, nil
 }
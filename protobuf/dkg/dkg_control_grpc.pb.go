@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.3.0
-// - protoc             v3.20.3
+// - protoc             v4.25.3
 // source: dkg/dkg_control.proto
 
 package dkg
@@ -22,6 +22,7 @@ const (
 	DKGControl_Command_FullMethodName      = "/dkg.DKGControl/Command"
 	DKGControl_Packet_FullMethodName       = "/dkg.DKGControl/Packet"
 	DKGControl_DKGStatus_FullMethodName    = "/dkg.DKGControl/DKGStatus"
+	DKGControl_DKGState_FullMethodName     = "/dkg.DKGControl/DKGState"
 	DKGControl_BroadcastDKG_FullMethodName = "/dkg.DKGControl/BroadcastDKG"
 )
 
@@ -32,6 +33,8 @@ type DKGControlClient interface {
 	Command(ctx context.Context, in *DKGCommand, opts ...grpc.CallOption) (*EmptyDKGResponse, error)
 	Packet(ctx context.Context, in *GossipPacket, opts ...grpc.CallOption) (*EmptyDKGResponse, error)
 	DKGStatus(ctx context.Context, in *DKGStatusRequest, opts ...grpc.CallOption) (*DKGStatusResponse, error)
+	// DKGState returns the whole current DKG state of a beacon, except for its key share
+	DKGState(ctx context.Context, in *DKGStatusRequest, opts ...grpc.CallOption) (*DKGStateResponse, error)
 	BroadcastDKG(ctx context.Context, in *DKGPacket, opts ...grpc.CallOption) (*EmptyDKGResponse, error)
 }
 
@@ -70,6 +73,15 @@ func (c *dKGControlClient) DKGStatus(ctx context.Context, in *DKGStatusRequest,
 	return out, nil
 }
 
+func (c *dKGControlClient) DKGState(ctx context.Context, in *DKGStatusRequest, opts ...grpc.CallOption) (*DKGStateResponse, error) {
+	out := new(DKGStateResponse)
+	err := c.cc.Invoke(ctx, DKGControl_DKGState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dKGControlClient) BroadcastDKG(ctx context.Context, in *DKGPacket, opts ...grpc.CallOption) (*EmptyDKGResponse, error) {
 	out := new(EmptyDKGResponse)
 	err := c.cc.Invoke(ctx, DKGControl_BroadcastDKG_FullMethodName, in, out, opts...)
@@ -86,6 +98,8 @@ type DKGControlServer interface {
 	Command(context.Context, *DKGCommand) (*EmptyDKGResponse, error)
 	Packet(context.Context, *GossipPacket) (*EmptyDKGResponse, error)
 	DKGStatus(context.Context, *DKGStatusRequest) (*DKGStatusResponse, error)
+	// DKGState returns the whole current DKG state of a beacon, except for its key share
+	DKGState(context.Context, *DKGStatusRequest) (*DKGStateResponse, error)
 	BroadcastDKG(context.Context, *DKGPacket) (*EmptyDKGResponse, error)
 }
 
@@ -102,6 +116,9 @@ func (UnimplementedDKGControlServer) Packet(context.Context, *GossipPacket) (*Em
 func (UnimplementedDKGControlServer) DKGStatus(context.Context, *DKGStatusRequest) (*DKGStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DKGStatus not implemented")
 }
+func (UnimplementedDKGControlServer) DKGState(context.Context, *DKGStatusRequest) (*DKGStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DKGState not implemented")
+}
 func (UnimplementedDKGControlServer) BroadcastDKG(context.Context, *DKGPacket) (*EmptyDKGResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BroadcastDKG not implemented")
 }
@@ -171,6 +188,24 @@ func _DKGControl_DKGStatus_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DKGControl_DKGState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DKGStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DKGControlServer).DKGState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DKGControl_DKGState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DKGControlServer).DKGState(ctx, req.(*DKGStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DKGControl_BroadcastDKG_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DKGPacket)
 	if err := dec(in); err != nil {
@@ -208,6 +243,10 @@ var DKGControl_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DKGStatus",
 			Handler:    _DKGControl_DKGStatus_Handler,
 		},
+		{
+			MethodName: "DKGState",
+			Handler:    _DKGControl_DKGState_Handler,
+		},
 		{
 			MethodName: "BroadcastDKG",
 			Handler:    _DKGControl_BroadcastDKG_Handler,
@@ -6,7 +6,6 @@ import (
 
 	"github.com/drand/drand/v2/common/chain"
 	"github.com/drand/drand/v2/common/client"
-	"github.com/drand/drand/v2/crypto"
 	"github.com/drand/drand/v2/internal/core"
 	"github.com/drand/drand/v2/protobuf/drand"
 )
@@ -29,7 +28,7 @@ func (c *GrpcClient) Get(ctx context.Context, round uint64) (client.Result, erro
 	}
 
 	// we need to set the randomness now since it isn't sent over the wire anymore in V2
-	rand.Randomness = crypto.RandomnessFromSignature(rand.GetSignature())
+	rand.Randomness = c.s.d.Scheme.RandomnessFromSignature(rand.GetSignature())
 
 	return rand, nil
 }
@@ -92,7 +92,7 @@ func (s *Server) PublicRand(_ context.Context, in *drand.PublicRandRequest) (*dr
 	if s.d.BadSecondRound && in.GetRound() == uint64(s.d.Round) {
 		signature = []byte{0x01, 0x02, 0x03}
 	}
-	randomness := sha256Hash(signature, 0)
+	randomness := s.d.Scheme.RandomnessFromSignature(signature)
 	resp := drand.PublicRandResponse{
 		Round:             uint64(s.d.Round),
 		PreviousSignature: prev,